@@ -12,13 +12,24 @@ var (
 	//go:embed keys/org-level-admin-sa.json
 	orgLevelAdminSAJSON []byte
 
+	//go:embed keys/system-api-sa.pem
+	systemAPIKey []byte
+
 	//go:embed config.json
 	configJson []byte
 )
 
+const systemAPIUser = "system-api-sa"
+
 type Config struct {
 	OrgLevel      IsolatedInstance
 	InstanceLevel IsolatedInstance
+	SystemAPI     SystemAPIUser
+}
+
+type SystemAPIUser struct {
+	User string
+	Key  []byte
 }
 
 type IsolatedInstance struct {
@@ -30,6 +41,7 @@ func GetConfig() Config {
 	val := Config{
 		OrgLevel:      IsolatedInstance{AdminSAJSON: orgLevelAdminSAJSON},
 		InstanceLevel: IsolatedInstance{AdminSAJSON: instanceLevelAdminSAJSON},
+		SystemAPI:     SystemAPIUser{User: systemAPIUser, Key: systemAPIKey},
 	}
 	if err := json.Unmarshal(configJson, &val); err != nil {
 		panic(err)
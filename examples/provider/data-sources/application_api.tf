@@ -3,3 +3,8 @@ data "zitadel_application_api" "default" {
   project_id = data.zitadel_project.default.id
   app_id     = "123456789012345678"
 }
+
+data "zitadel_application_api" "by_client_id" {
+  org_id    = data.zitadel_org.default.id
+  client_id = "234567890123456789"
+}
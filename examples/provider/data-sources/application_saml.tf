@@ -3,3 +3,8 @@ data "zitadel_application_saml" "default" {
   project_id = data.zitadel_project.default.id
   app_id     = "123456789012345678"
 }
+
+data "zitadel_application_saml" "by_entity_id" {
+  org_id    = data.zitadel_org.default.id
+  entity_id = "http://example.com/saml/metadata"
+}
@@ -0,0 +1,5 @@
+data "zitadel_email_providers" "default" {}
+
+output "active_email_provider_id" {
+  value = data.zitadel_email_providers.default.active_email_provider_id
+}
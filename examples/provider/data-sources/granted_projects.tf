@@ -0,0 +1,12 @@
+data "zitadel_granted_projects" "default" {
+  org_id = data.zitadel_org.default.id
+  name   = "example"
+}
+
+resource "zitadel_user_grant" "default" {
+  org_id           = data.zitadel_org.default.id
+  project_id       = data.zitadel_granted_projects.default.granted_projects[0].project_id
+  project_grant_id = data.zitadel_granted_projects.default.granted_projects[0].project_grant_id
+  user_id          = data.zitadel_human_user.default.id
+  role_keys        = data.zitadel_granted_projects.default.granted_projects[0].role_keys
+}
@@ -6,3 +6,12 @@ data "zitadel_human_user" "default" {
 output "human_user" {
   value = data.zitadel_human_user.default
 }
+
+data "zitadel_human_user" "by_login_name" {
+  login_name = "humanfull@localhost.com@zitadel.localhost"
+}
+
+data "zitadel_human_user" "by_email" {
+  org_id = data.zitadel_org.default.id
+  email  = "test@zitadel.com"
+}
@@ -0,0 +1,17 @@
+data "zitadel_human_users" "default" {
+  org_id       = data.zitadel_org.default.id
+  email        = "example.com"
+  email_method = "TEXT_QUERY_METHOD_ENDS_WITH_IGNORE_CASE"
+  state        = "USER_STATE_ACTIVE"
+}
+
+data "zitadel_human_user" "default" {
+  for_each = toset(data.zitadel_human_users.default.user_ids)
+  user_id  = each.value
+}
+
+output "user_names" {
+  value = toset([
+    for user in data.zitadel_human_user.default : user.user_name
+  ])
+}
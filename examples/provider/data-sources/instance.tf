@@ -0,0 +1,7 @@
+data "zitadel_instance" "default" {
+  id = "123456789012345678"
+}
+
+output "instance" {
+  value = data.zitadel_instance.default
+}
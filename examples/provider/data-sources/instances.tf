@@ -0,0 +1,14 @@
+data "zitadel_instances" "default" {
+  domain = "customer.example.com"
+}
+
+data "zitadel_instance" "default" {
+  for_each = toset(data.zitadel_instances.default.ids)
+  id       = each.value
+}
+
+output "instance_names" {
+  value = toset([
+    for instance in data.zitadel_instance.default : instance.name
+  ])
+}
@@ -0,0 +1,7 @@
+data "zitadel_member_roles" "default" {
+  scope = "org"
+}
+
+output "org_member_roles" {
+  value = data.zitadel_member_roles.default.roles
+}
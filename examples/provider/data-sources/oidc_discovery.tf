@@ -0,0 +1,9 @@
+data "zitadel_oidc_discovery" "default" {}
+
+output "oidc_issuer" {
+  value = data.zitadel_oidc_discovery.default.issuer
+}
+
+output "oidc_jwks_uri" {
+  value = data.zitadel_oidc_discovery.default.jwks_uri
+}
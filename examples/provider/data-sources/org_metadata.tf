@@ -0,0 +1,8 @@
+data "zitadel_org_metadata" "default" {
+  org_id = data.zitadel_org.default.id
+  key    = "a_key"
+}
+
+output "org_metadata_value" {
+  value = data.zitadel_org_metadata.default.value
+}
@@ -0,0 +1,10 @@
+data "zitadel_project_grants" "default" {
+  org_id         = data.zitadel_org.default.id
+  project_id     = data.zitadel_project.default.id
+  granted_org_id = "123456789012345678"
+  role_keys      = ["super-user"]
+}
+
+output "project_grant_ids" {
+  value = data.zitadel_project_grants.default.project_grants[*].id
+}
@@ -0,0 +1,9 @@
+data "zitadel_project_roles" "default" {
+  org_id     = data.zitadel_org.default.id
+  project_id = data.zitadel_project.default.id
+  group      = "role_group"
+}
+
+output "project_role_keys" {
+  value = data.zitadel_project_roles.default.roles[*].key
+}
@@ -0,0 +1,9 @@
+data "zitadel_saml_idp_metadata" "default" {}
+
+output "saml_idp_entity_id" {
+  value = data.zitadel_saml_idp_metadata.default.entity_id
+}
+
+output "saml_idp_signing_certificate" {
+  value = data.zitadel_saml_idp_metadata.default.signing_certificates[0]
+}
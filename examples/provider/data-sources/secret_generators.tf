@@ -0,0 +1,7 @@
+data "zitadel_secret_generators" "default" {}
+
+output "secret_generator_expiries" {
+  value = {
+    for generator in data.zitadel_secret_generators.default.secret_generators : generator.generator_type => generator.expiry
+  }
+}
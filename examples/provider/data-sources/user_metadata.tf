@@ -0,0 +1,9 @@
+data "zitadel_user_metadata" "default" {
+  org_id  = data.zitadel_org.default.id
+  user_id = data.zitadel_human_user.default.id
+  key     = "a_json_key"
+}
+
+output "user_metadata_department" {
+  value = jsondecode(data.zitadel_user_metadata.default.value_json).department
+}
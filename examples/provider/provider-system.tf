@@ -0,0 +1,7 @@
+provider "zitadel" {
+  domain              = "localhost"
+  insecure            = "true"
+  port                = "8080"
+  system_api_user     = "system-api-user"
+  system_api_key_file = "system-api-user.pem"
+}
@@ -18,4 +18,7 @@ resource "zitadel_application_oidc" "default" {
   id_token_userinfo_assertion  = false
   additional_origins           = []
   skip_native_app_success_page = false
+  back_channel_logout_uri      = "https://localhost.com/backchannel"
+  login_version                = "LOGIN_VERSION_2"
+  login_base_uri               = "https://localhost.com/ui/v2/login"
 }
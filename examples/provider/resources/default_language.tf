@@ -0,0 +1,3 @@
+resource "zitadel_default_language" "default" {
+  language = "en"
+}
@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `domain[:org_id]`, e.g.
+terraform import zitadel_domain_verification.imported 'example.com:123456789012345678'
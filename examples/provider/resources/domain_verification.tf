@@ -0,0 +1,12 @@
+resource "zitadel_domain_verification" "default" {
+  org_id = data.zitadel_org.default.id
+  domain = zitadel_domain.default.name
+
+  # publish zitadel_domain.default.validation_token first,
+  # for example in a TXT record named zitadel_domain.default.validation_dns_record,
+  # and reference the record resource in depends_on
+
+  timeouts {
+    create = "15m"
+  }
+}
@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `<id[:signing_key]>`, e.g.
+terraform import zitadel_email_provider_http.imported '123456789012345678:s1gn1ngk3y'
@@ -13,4 +13,5 @@ resource "zitadel_human_user" "default" {
   is_email_verified            = true
   initial_password             = "Password1!"
   initial_skip_password_change = true
+  state                        = "active"
 }
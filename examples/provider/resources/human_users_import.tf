@@ -0,0 +1,6 @@
+resource "zitadel_human_users_import" "default" {
+  org_id      = data.zitadel_org.default.id
+  file        = "${path.module}/users.csv"
+  format      = "csv"
+  parallelism = 10
+}
@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `<id[:owner_password]>`, e.g.
+terraform import zitadel_instance.imported '123456789012345678:Password1!'
@@ -0,0 +1,13 @@
+resource "zitadel_instance" "default" {
+  name                           = "customer-instance"
+  first_org_name                 = "customer"
+  custom_domain                  = "customer.example.com"
+  default_language               = "en"
+  owner_user_name                = "zitadel-admin"
+  owner_email                    = "admin@example.com"
+  owner_email_verified           = true
+  owner_first_name               = "Instance"
+  owner_last_name                = "Admin"
+  owner_password                 = "Password1!"
+  owner_password_change_required = true
+}
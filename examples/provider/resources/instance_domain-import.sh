@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `<domain:instance_id>`, e.g.
+terraform import zitadel_instance_domain.imported 'login.example.com:123456789012345678'
@@ -0,0 +1,5 @@
+resource "zitadel_instance_domain" "default" {
+  instance_id = data.zitadel_instance.default.id
+  domain      = "login.example.com"
+  is_primary  = false
+}
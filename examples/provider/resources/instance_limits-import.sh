@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `<instance_id>`, e.g.
+terraform import zitadel_instance_limits.imported '123456789012345678'
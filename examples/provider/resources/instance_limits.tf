@@ -0,0 +1,5 @@
+resource "zitadel_instance_limits" "default" {
+  instance_id         = data.zitadel_instance.default.id
+  audit_log_retention = "720h"
+  block               = false
+}
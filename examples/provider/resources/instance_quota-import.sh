@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `<instance_id:unit>`, e.g.
+terraform import zitadel_instance_quota.imported '123456789012345678:UNIT_REQUESTS_ALL_AUTHENTICATED'
@@ -0,0 +1,13 @@
+resource "zitadel_instance_quota" "default" {
+  instance_id    = data.zitadel_instance.default.id
+  unit           = "UNIT_REQUESTS_ALL_AUTHENTICATED"
+  from           = "2024-01-01T00:00:00Z"
+  reset_interval = "720h"
+  amount         = 100000
+  limit          = true
+  notifications {
+    percent  = 80
+    repeat   = false
+    call_url = "https://billing.example.com/quota"
+  }
+}
@@ -0,0 +1,4 @@
+resource "zitadel_instance_restrictions" "default" {
+  disallow_public_org_registration = true
+  allowed_languages                = ["de", "en"]
+}
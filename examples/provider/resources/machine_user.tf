@@ -4,4 +4,5 @@ resource "zitadel_machine_user" "default" {
   name        = "name"
   description = "a machine user"
   with_secret = false
+  state       = "active"
 }
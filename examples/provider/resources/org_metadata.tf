@@ -3,3 +3,18 @@ resource "zitadel_org_metadata" "default" {
   key    = "a_key"
   value  = "a_value"
 }
+
+resource "zitadel_org_metadata" "binary" {
+  org_id       = data.zitadel_org.default.id
+  key          = "a_binary_key"
+  value_base64 = base64encode("a_binary_value")
+}
+
+resource "zitadel_org_metadata" "json" {
+  org_id = data.zitadel_org.default.id
+  key    = "a_json_key"
+  value_json = jsonencode({
+    region = "eu"
+    tier   = 2
+  })
+}
@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `<[org_id]>`, e.g.
+terraform import zitadel_org_metadata_set.imported '123456789012345678'
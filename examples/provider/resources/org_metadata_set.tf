@@ -0,0 +1,7 @@
+resource "zitadel_org_metadata_set" "default" {
+  org_id = data.zitadel_org.default.id
+  metadata = {
+    a_key       = "a_value"
+    another_key = "another_value"
+  }
+}
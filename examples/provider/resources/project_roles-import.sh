@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `project_id[:org_id]`, e.g.
+terraform import zitadel_project_roles.imported '123456789012345678:123456789012345678'
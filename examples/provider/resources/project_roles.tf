@@ -0,0 +1,16 @@
+resource "zitadel_project_roles" "default" {
+  org_id     = data.zitadel_org.default.id
+  project_id = data.zitadel_project.default.id
+
+  roles {
+    key          = "super-user"
+    display_name = "Super User"
+    group        = "role_group"
+  }
+
+  roles {
+    key          = "reader"
+    display_name = "Reader"
+    group        = "role_group"
+  }
+}
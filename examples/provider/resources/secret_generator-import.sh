@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `<generator_type>`, e.g.
+terraform import zitadel_secret_generator.imported 'SECRET_GENERATOR_TYPE_INIT_CODE'
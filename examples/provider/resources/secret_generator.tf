@@ -0,0 +1,9 @@
+resource "zitadel_secret_generator" "default" {
+  generator_type        = "SECRET_GENERATOR_TYPE_INIT_CODE"
+  length                = 8
+  expiry                = "72h0m0s"
+  include_lower_letters = false
+  include_upper_letters = true
+  include_digits        = true
+  include_symbols       = false
+}
@@ -6,4 +6,7 @@ resource "zitadel_smtp_config" "default" {
   user             = "user"
   password         = "secret_password"
   reply_to_address = "replyto@example.com"
+  description      = "primary smtp server"
+  set_active       = true
+  test_recipient   = "admin@example.com"
 }
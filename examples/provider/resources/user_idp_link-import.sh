@@ -0,0 +1,2 @@
+# The resource can be imported using the ID format `<user_id:idp_id:provided_user_id[:org_id]>`, e.g.
+terraform import zitadel_user_idp_link.imported '123456789012345678:123456789012345678:123456789:123456789012345678'
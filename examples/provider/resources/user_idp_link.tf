@@ -0,0 +1,7 @@
+resource "zitadel_user_idp_link" "default" {
+  org_id             = data.zitadel_org.default.id
+  user_id            = data.zitadel_human_user.default.id
+  idp_id             = data.zitadel_idp_google.default.id
+  provided_user_id   = "123456789"
+  provided_user_name = "user@example.com"
+}
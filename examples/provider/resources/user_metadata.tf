@@ -4,3 +4,20 @@ resource "zitadel_user_metadata" "default" {
   key     = "a_key"
   value   = "a_value"
 }
+
+resource "zitadel_user_metadata" "binary" {
+  org_id       = data.zitadel_org.default.id
+  user_id      = data.zitadel_human_user.default.id
+  key          = "a_binary_key"
+  value_base64 = base64encode("a_binary_value")
+}
+
+resource "zitadel_user_metadata" "json" {
+  org_id  = data.zitadel_org.default.id
+  user_id = data.zitadel_human_user.default.id
+  key     = "a_json_key"
+  value_json = jsonencode({
+    department  = "engineering"
+    cost_center = 42
+  })
+}
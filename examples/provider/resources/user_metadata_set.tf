@@ -0,0 +1,8 @@
+resource "zitadel_user_metadata_set" "default" {
+  org_id  = data.zitadel_org.default.id
+  user_id = data.zitadel_human_user.default.id
+  metadata = {
+    a_key       = "a_value"
+    another_key = "another_value"
+  }
+}
@@ -11,18 +11,22 @@ import (
 
 func GetDatasource() *schema.Resource {
 	return &schema.Resource{
-		Description: "Datasource representing an API application belonging to a project, with all configuration possibilities.",
+		Description: "Datasource representing an API application belonging to a project, with all configuration possibilities. The application is looked up by exactly one of app_id or client_id. Looking it up by client_id only finds applications of projects the organization owns, not of granted projects.",
 		Schema: map[string]*schema.Schema{
 			AppIDVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The ID of this resource.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The ID of this resource.",
+				ExactlyOneOf: []string{AppIDVar, ClientIDVar},
+				RequiredWith: []string{ProjectIDVar},
 			},
 			helper.OrgIDVar: helper.OrgIDDatasourceField,
 			ProjectIDVar: {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "ID of the project",
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the project, required if the application is looked up by app_id",
 			},
 			NameVar: {
 				Type:        schema.TypeString,
@@ -36,12 +40,13 @@ func GetDatasource() *schema.Resource {
 			},
 			ClientIDVar: {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "generated ID for this config",
+				Description: "Client ID, the application is looked up by it if no app_id is given by listing the apps of all projects the organization owns, which needs a request per project once per run of the provider. Applications of granted projects are not found",
 				Sensitive:   true,
 			},
 		},
-		ReadContext: read,
+		ReadContext: get,
 	}
 }
 
@@ -20,6 +20,8 @@ func TestAccApplicationAPIDatasource_ID(t *testing.T) {
 	frame := test_utils.NewOrgTestFrame(t, datasourceName)
 	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
 	exampleID := test_utils.AttributeValue(t, application_api.AppIDVar, attributes).AsString()
+	// the second block looks the application up by client_id
+	config = strings.Join(strings.Split(config, "\n")[0:5], "\n")
 	projectDep, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
 	appName := "application_api_datasource_" + frame.UniqueResourcesID
 	_, appID, clientID := application_api_test_dep.Create(t, frame, projectID, appName)
@@ -40,6 +42,31 @@ func TestAccApplicationAPIDatasource_ID(t *testing.T) {
 	)
 }
 
+func TestAccApplicationAPIDatasource_ClientID(t *testing.T) {
+	datasourceName := "zitadel_application_api"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, _ := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	config = strings.Join(strings.Split(config, "\n")[6:10], "\n")
+	_, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	appName := "application_api_datasource_" + frame.UniqueResourcesID
+	_, appID, clientID := application_api_test_dep.Create(t, frame, projectID, appName)
+	config = strings.Replace(config, "234567890123456789", clientID, 1)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		nil,
+		map[string]string{
+			"org_id":     frame.OrgID,
+			"project_id": projectID,
+			"app_id":     appID,
+			"name":       appName,
+			"client_id":  clientID,
+		},
+	)
+}
+
 func TestAccApplicationAPIsDatasources_ID_Name_Match(t *testing.T) {
 	datasourceName := "zitadel_application_apis"
 	frame := test_utils.NewOrgTestFrame(t, datasourceName)
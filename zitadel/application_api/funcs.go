@@ -26,11 +26,7 @@ func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	_, err = client.RemoveApp(helper.CtxWithOrgID(ctx, d), &management.RemoveAppRequest{
-		ProjectId: d.Get(ProjectIDVar).(string),
-		AppId:     d.Id(),
-	})
-	if err != nil {
+	if err := helper.DeactivateOrRemoveApp(helper.CtxWithOrgID(ctx, d), client, d, d.Get(ProjectIDVar).(string)); err != nil {
 		return diag.Errorf("failed to delete applicationAPI: %v", err)
 	}
 	return nil
@@ -56,7 +52,7 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			AppId:     d.Id(),
 			Name:      d.Get(NameVar).(string),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update application: %v", err)
 		}
 	}
@@ -67,10 +63,25 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			AppId:          d.Id(),
 			AuthMethodType: app.APIAuthMethodType(app.APIAuthMethodType_value[d.Get(authMethodTypeVar).(string)]),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update applicationAPI: %v", err)
 		}
 	}
+
+	// the secret of an adopted application isn't known, so it gets a new one
+	rotate := d.IsNewResource() || d.HasChange(helper.SecretRotationTriggersVar)
+	if rotate && hasSecret(d.Get(authMethodTypeVar).(string)) {
+		resp, err := client.RegenerateAPIClientSecret(helper.CtxWithOrgID(ctx, d), &management.RegenerateAPIClientSecretRequest{
+			ProjectId: projectID,
+			AppId:     d.Id(),
+		})
+		if err != nil {
+			return diag.Errorf("failed to regenerate applicationAPI client secret: %v", err)
+		}
+		if err := d.Set(ClientSecretVar, resp.GetClientSecret()); err != nil {
+			return diag.Errorf("failed to set %s of applicationAPI: %v", ClientSecretVar, err)
+		}
+	}
 	return nil
 }
 
@@ -87,6 +98,20 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
+	if helper.DeactivateOnDestroy(d) {
+		appID, err := helper.ReactivateDeactivatedApp(helper.CtxWithOrgID(ctx, d), clientinfo, client, d.Get(ProjectIDVar).(string), d.Get(NameVar).(string), func(a *app.App) bool { return a.GetApiConfig() != nil })
+		if err != nil {
+			return diag.Errorf("failed to adopt deactivated applicationAPI: %v", err)
+		}
+		if appID != "" {
+			d.SetId(appID)
+			if diags := update(ctx, d, m); diags.HasError() {
+				return diags
+			}
+			return read(ctx, d, m)
+		}
+	}
+
 	resp, err := client.AddAPIApp(helper.CtxWithOrgID(ctx, d), &management.AddAPIAppRequest{
 		ProjectId:      d.Get(ProjectIDVar).(string),
 		Name:           d.Get(NameVar).(string),
@@ -148,6 +173,46 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	return nil
 }
 
+// get reads the application by app_id or looks it up by its client ID in all projects of the organization
+func get(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started get")
+
+	if _, ok := d.GetOk(AppIDVar); ok {
+		return read(ctx, d, m)
+	}
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientID := d.Get(ClientIDVar).(string)
+	projectID, found, err := helper.FindApp(ctx, client, d.Get(helper.OrgIDVar).(string), func(a *app.App) bool {
+		return a.GetApiConfig().GetClientId() == clientID
+	})
+	if err != nil {
+		return diag.Errorf("failed to get applicationAPI by client ID: %v", err)
+	}
+	if found == nil {
+		return diag.Errorf("no API application found with client ID %s", clientID)
+	}
+	set := map[string]interface{}{
+		AppIDVar:     found.GetId(),
+		ProjectIDVar: projectID,
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of applicationAPI: %v", k, err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
 func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started list")
 	name := d.Get(NameVar).(string)
@@ -189,3 +254,16 @@ func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	d.SetId("-")
 	return diag.FromErr(d.Set(appIDsVar, ids))
 }
+
+// hasSecret returns true if ZITADEL generates a client secret for the auth method type
+func hasSecret(authMethodType string) bool {
+	return authMethodType == app.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC.String()
+}
+
+// customizeDiff plans a new client secret if the rotation triggers changed
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !hasSecret(d.Get(authMethodTypeVar).(string)) || d.HasChange(authMethodTypeVar) || !d.HasChange(helper.SecretRotationTriggersVar) {
+		return nil
+	}
+	return d.SetNewComputed(ClientSecretVar)
+}
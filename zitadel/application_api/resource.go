@@ -13,7 +13,8 @@ func GetResource() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource representing an API application belonging to a project, with all configuration possibilities.",
 		Schema: map[string]*schema.Schema{
-			helper.OrgIDVar: helper.OrgIDResourceField,
+			helper.OrgIDVar:     helper.OrgIDResourceField,
+			helper.OnDestroyVar: helper.OnDestroyResourceField,
 			ProjectIDVar: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -46,11 +47,13 @@ func GetResource() *schema.Resource {
 				Description: "generated secret for this config",
 				Sensitive:   true,
 			},
+			helper.SecretRotationTriggersVar: helper.SecretRotationTriggersResourceField,
 		},
 		DeleteContext: delete,
 		CreateContext: create,
 		UpdateContext: update,
 		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			AppIDVar,
 			helper.NewImportAttribute(ProjectIDVar, helper.ConvertID, false),
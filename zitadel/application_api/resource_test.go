@@ -2,10 +2,12 @@ package application_api_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/app"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/application_api"
@@ -40,6 +42,47 @@ func TestAccAppAPI(t *testing.T) {
 	)
 }
 
+func TestAccAppAPISecretRotation(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_application_api")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleName := test_utils.AttributeValue(t, application_api.NameVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, exampleName, frame.UniqueResourcesID, 1)
+	projectDep, _ := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	test_utils.RunSecretRotationTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, projectDep},
+		func(trigger string) string {
+			return test_utils.WithSecretRotationTrigger(resourceExample, trigger)
+		},
+		application_api.ClientSecretVar,
+	)
+}
+
+func TestAccAppAPIOnDestroyDeactivate(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_application_api")
+	projectDep, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	resourceExample = test_utils.WithOnDestroyDeactivate(resourceExample)
+	exampleAuthMethodType := test_utils.AttributeValue(t, "auth_method_type", exampleAttributes).AsString()
+	test_utils.RunOnDestroyDeactivateTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, projectDep},
+		resourceExample, strings.Replace(resourceExample, exampleAuthMethodType, "API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT", 1),
+		func(id string, active bool) error {
+			remoteResource, err := frame.GetAppByID(frame, &management.GetAppByIDRequest{AppId: id, ProjectId: projectID})
+			if err != nil {
+				return err
+			}
+			if actual := remoteResource.GetApp().GetState() == app.AppState_APP_STATE_ACTIVE; actual != active {
+				return fmt.Errorf("expected app to be active %t, but got state %s", active, remoteResource.GetApp().GetState())
+			}
+			return nil
+		},
+	)
+}
+
 func checkRemoteProperty(frame *test_utils.OrgTestFrame, projectId string) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
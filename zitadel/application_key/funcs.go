@@ -2,6 +2,7 @@ package application_key
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -9,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/authn"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
@@ -58,13 +58,11 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		Type:      authn.KeyType(authn.KeyType_value[keyType]),
 	}
 
-	if expiration, ok := d.GetOk(ExpirationDateVar); ok {
-		t, err := time.Parse(time.RFC3339, expiration.(string))
-		if err != nil {
-			return diag.Errorf("failed to parse time: %v", err)
-		}
-		req.ExpirationDate = timestamppb.New(t)
+	expirationDate, err := helper.GetExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	req.ExpirationDate = expirationDate
 
 	resp, err := client.AddAppKey(helper.CtxWithOrgID(ctx, d), req)
 	if err != nil {
@@ -74,6 +72,12 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	if err := d.Set(KeyDetailsVar, string(resp.GetKeyDetails())); err != nil {
 		return diag.FromErr(err)
 	}
+	// The expiration date is needed in the state to plan the rotation, also if it is computed from the lifetime
+	if expirationDate != nil {
+		if err := d.Set(ExpirationDateVar, expirationDate.AsTime().Format(time.RFC3339)); err != nil {
+			return diag.Errorf("failed to set %s of app key: %v", ExpirationDateVar, err)
+		}
+	}
 	return nil
 }
 
@@ -106,16 +110,38 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	d.SetId(resp.GetKey().GetId())
 
 	set := map[string]interface{}{
-		ExpirationDateVar: resp.GetKey().GetExpirationDate().AsTime().Format(time.RFC3339),
-		ProjectIDVar:      projectID,
-		AppIDVar:          appID,
-		helper.OrgIDVar:   d.Get(helper.OrgIDVar).(string),
-		keyTypeVar:        resp.GetKey().GetType().String(),
+		ExpirationDateVar:   resp.GetKey().GetExpirationDate().AsTime().Format(time.RFC3339),
+		helper.ExpiresInVar: helper.ExpiresIn(resp.GetKey().GetExpirationDate().AsTime()),
+		ProjectIDVar:        projectID,
+		AppIDVar:            appID,
+		helper.OrgIDVar:     d.Get(helper.OrgIDVar).(string),
+		keyTypeVar:          resp.GetKey().GetType().String(),
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
 			return diag.Errorf("failed to set %s of app key: %v", k, err)
 		}
 	}
-	return nil
+	return helper.ExpiryWarning(d, "app key", resp.GetKey().GetExpirationDate().AsTime())
+}
+
+// customizeDiff plans the replacement of the app key if it is due for rotation
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return helper.CustomizeDiffRotateBefore(ctx, d, m, creationDate, KeyDetailsVar)
+}
+
+func creationDate(ctx context.Context, d *schema.ResourceDiff, clientinfo *helper.ClientInfo) (time.Time, error) {
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return time.Time{}, err
+	}
+	resp, err := client.GetAppKey(helper.CtxSetOrgID(ctx, d.Get(helper.OrgIDVar).(string)), &management.GetAppKeyRequest{
+		ProjectId: d.Get(ProjectIDVar).(string),
+		AppId:     d.Get(AppIDVar).(string),
+		KeyId:     d.Id(),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get app key: %v", err)
+	}
+	return resp.GetKey().GetDetails().GetCreationDate().AsTime(), nil
 }
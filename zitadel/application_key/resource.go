@@ -36,11 +36,16 @@ func GetResource() *schema.Resource {
 				},
 			},
 			ExpirationDateVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Expiration date of the app key in the RFC3339 format",
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Expiration date of the app key in the RFC3339 format",
+				ForceNew:     true,
+				ExactlyOneOf: []string{ExpirationDateVar, helper.LifetimeVar},
 			},
+			helper.LifetimeVar:     helper.LifetimeResourceField,
+			helper.RotateBeforeVar: helper.RotateBeforeResourceField,
+			helper.ExpiresInVar:    helper.ExpiresInResourceField,
 			KeyDetailsVar: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -51,6 +56,9 @@ func GetResource() *schema.Resource {
 		DeleteContext: delete,
 		CreateContext: create,
 		ReadContext:   read,
+		// lifetime and rotate_before only affect future replacements, so an update only refreshes the state
+		UpdateContext: read,
+		CustomizeDiff: customizeDiff,
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			keyIDVar,
 			helper.NewImportAttribute(ProjectIDVar, helper.ConvertID, false),
@@ -20,6 +20,8 @@ func TestAccApplicationOIDCDatasource_ID(t *testing.T) {
 	frame := test_utils.NewOrgTestFrame(t, datasourceName)
 	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
 	exampleID := test_utils.AttributeValue(t, application_oidc.AppIDVar, attributes).AsString()
+	// the second block looks the application up by client_id
+	config = strings.Join(strings.Split(config, "\n")[0:5], "\n")
 	projectDep, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
 	appName := "application_oidc_datasource_" + frame.UniqueResourcesID
 	_, appID, clientID := application_oidc_test_dep.Create(t, frame, projectID, appName)
@@ -30,6 +32,32 @@ func TestAccApplicationOIDCDatasource_ID(t *testing.T) {
 		config,
 		[]string{frame.AsOrgDefaultDependency, projectDep},
 		nil,
+		map[string]string{
+			"org_id":        frame.OrgID,
+			"project_id":    projectID,
+			"app_id":        appID,
+			"name":          appName,
+			"client_id":     clientID,
+			"login_version": "LOGIN_VERSION_UNSPECIFIED",
+		},
+	)
+}
+
+func TestAccApplicationOIDCDatasource_ClientID(t *testing.T) {
+	datasourceName := "zitadel_application_oidc"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, _ := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	config = strings.Join(strings.Split(config, "\n")[6:10], "\n")
+	_, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	appName := "application_oidc_datasource_" + frame.UniqueResourcesID
+	_, appID, clientID := application_oidc_test_dep.Create(t, frame, projectID, appName)
+	config = strings.Replace(config, "234567890123456789", clientID, 1)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		nil,
 		map[string]string{
 			"org_id":     frame.OrgID,
 			"project_id": projectID,
@@ -28,11 +28,7 @@ func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	_, err = client.RemoveApp(helper.CtxWithOrgID(ctx, d), &management.RemoveAppRequest{
-		ProjectId: d.Get(ProjectIDVar).(string),
-		AppId:     d.Id(),
-	})
-	if err != nil {
+	if err := helper.DeactivateOrRemoveApp(helper.CtxWithOrgID(ctx, d), client, d, d.Get(ProjectIDVar).(string)); err != nil {
 		return diag.Errorf("failed to delete applicationOIDC: %v", err)
 	}
 	return nil
@@ -59,7 +55,7 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			AppId:     d.Id(),
 			Name:      d.Get(NameVar).(string),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update application: %v", err)
 		}
 	}
@@ -79,6 +75,9 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		clockSkewVar,
 		additionalOriginsVar,
 		skipNativeAppSuccessPageVar,
+		backChannelLogoutURIVar,
+		helper.LoginVersionVar,
+		helper.LoginBaseURIVar,
 	) {
 		respTypes := make([]app.OIDCResponseType, 0)
 		for _, respType := range d.Get(responseTypesVar).([]interface{}) {
@@ -92,6 +91,10 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		loginVersion, err := helper.GetLoginVersion(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
 		_, err = client.UpdateOIDCAppConfig(helper.CtxWithOrgID(ctx, d), &management.UpdateOIDCAppConfigRequest{
 			ProjectId:                projectID,
@@ -110,11 +113,28 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			AdditionalOrigins:        interfaceToStringSlice(d.Get(additionalOriginsVar)),
 			ClockSkew:                durationpb.New(dur),
 			SkipNativeAppSuccessPage: d.Get(skipNativeAppSuccessPageVar).(bool),
+			BackChannelLogoutUri:     d.Get(backChannelLogoutURIVar).(string),
+			LoginVersion:             loginVersion,
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update applicationOIDC: %v", err)
 		}
 	}
+
+	// the secret of an adopted application isn't known, so it gets a new one
+	rotate := d.IsNewResource() || d.HasChange(helper.SecretRotationTriggersVar)
+	if rotate && hasSecret(d.Get(authMethodTypeVar).(string)) {
+		resp, err := client.RegenerateOIDCClientSecret(helper.CtxWithOrgID(ctx, d), &management.RegenerateOIDCClientSecretRequest{
+			ProjectId: projectID,
+			AppId:     d.Id(),
+		})
+		if err != nil {
+			return diag.Errorf("failed to regenerate applicationOIDC client secret: %v", err)
+		}
+		if err := d.Set(ClientSecretVar, resp.GetClientSecret()); err != nil {
+			return diag.Errorf("failed to set %s of applicationOIDC: %v", ClientSecretVar, err)
+		}
+	}
 	return nil
 }
 
@@ -131,6 +151,20 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
+	if helper.DeactivateOnDestroy(d) {
+		appID, err := helper.ReactivateDeactivatedApp(helper.CtxWithOrgID(ctx, d), clientinfo, client, d.Get(ProjectIDVar).(string), d.Get(NameVar).(string), func(a *app.App) bool { return a.GetOidcConfig() != nil })
+		if err != nil {
+			return diag.Errorf("failed to adopt deactivated applicationOIDC: %v", err)
+		}
+		if appID != "" {
+			d.SetId(appID)
+			if diags := update(ctx, d, m); diags.HasError() {
+				return diags
+			}
+			return read(ctx, d, m)
+		}
+	}
+
 	respTypes := make([]app.OIDCResponseType, 0)
 	for _, respType := range d.Get(responseTypesVar).([]interface{}) {
 		respTypes = append(respTypes, app.OIDCResponseType(app.OIDCResponseType_value[respType.(string)]))
@@ -144,6 +178,10 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	loginVersion, err := helper.GetLoginVersion(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	resp, err := client.AddOIDCApp(helper.CtxWithOrgID(ctx, d), &management.AddOIDCAppRequest{
 		ProjectId:                d.Get(ProjectIDVar).(string),
@@ -163,6 +201,8 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		AdditionalOrigins:        interfaceToStringSlice(d.Get(additionalOriginsVar)),
 		Version:                  app.OIDCVersion(app.OIDCVersion_value[d.Get(versionVar).(string)]),
 		SkipNativeAppSuccessPage: d.Get(skipNativeAppSuccessPageVar).(bool),
+		BackChannelLogoutUri:     d.Get(backChannelLogoutURIVar).(string),
+		LoginVersion:             loginVersion,
 	})
 
 	set := map[string]interface{}{
@@ -238,6 +278,10 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 		additionalOriginsVar:        oidc.GetAdditionalOrigins(),
 		ClientIDVar:                 oidc.GetClientId(),
 		skipNativeAppSuccessPageVar: oidc.GetSkipNativeAppSuccessPage(),
+		backChannelLogoutURIVar:     oidc.GetBackChannelLogoutUri(),
+	}
+	for k, v := range helper.LoginVersionState(oidc.GetLoginVersion()) {
+		set[k] = v
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
@@ -248,6 +292,20 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	return nil
 }
 
+// hasSecret returns true if ZITADEL generates a client secret for the auth method type
+func hasSecret(authMethodType string) bool {
+	return authMethodType == app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC.String() ||
+		authMethodType == app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_POST.String()
+}
+
+// customizeDiff plans a new client secret if the rotation triggers changed
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !hasSecret(d.Get(authMethodTypeVar).(string)) || d.HasChange(authMethodTypeVar) || !d.HasChange(helper.SecretRotationTriggersVar) {
+		return nil
+	}
+	return d.SetNewComputed(ClientSecretVar)
+}
+
 func interfaceToStringSlice(in interface{}) []string {
 	slice := in.([]interface{})
 	ret := make([]string, 0)
@@ -257,6 +315,46 @@ func interfaceToStringSlice(in interface{}) []string {
 	return ret
 }
 
+// get reads the application by app_id or looks it up by its client ID in all projects of the organization
+func get(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started get")
+
+	if _, ok := d.GetOk(AppIDVar); ok {
+		return read(ctx, d, m)
+	}
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientID := d.Get(ClientIDVar).(string)
+	projectID, found, err := helper.FindApp(ctx, client, d.Get(helper.OrgIDVar).(string), func(a *app.App) bool {
+		return a.GetOidcConfig().GetClientId() == clientID
+	})
+	if err != nil {
+		return diag.Errorf("failed to get applicationOIDC by client ID: %v", err)
+	}
+	if found == nil {
+		return diag.Errorf("no OIDC application found with client ID %s", clientID)
+	}
+	set := map[string]interface{}{
+		AppIDVar:     found.GetId(),
+		ProjectIDVar: projectID,
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of applicationOIDC: %v", k, err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
 func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started list")
 	name := d.Get(NameVar).(string)
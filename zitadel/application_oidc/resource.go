@@ -13,7 +13,8 @@ func GetResource() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource representing an OIDC application belonging to a project, with all configuration possibilities.",
 		Schema: map[string]*schema.Schema{
-			helper.OrgIDVar: helper.OrgIDResourceField,
+			helper.OrgIDVar:     helper.OrgIDResourceField,
+			helper.OnDestroyVar: helper.OnDestroyResourceField,
 			ProjectIDVar: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -150,11 +151,20 @@ func GetResource() *schema.Resource {
 				Optional:    true,
 				Description: "Skip the successful login page on native apps and directly redirect the user to the callback.",
 			},
+			backChannelLogoutURIVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URI to which the logout token is sent on back-channel logout",
+			},
+			helper.LoginVersionVar:           helper.LoginVersionResourceField,
+			helper.LoginBaseURIVar:           helper.LoginBaseURIResourceField,
+			helper.SecretRotationTriggersVar: helper.SecretRotationTriggersResourceField,
 		},
 		DeleteContext: delete,
 		CreateContext: create,
 		UpdateContext: update,
 		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			AppIDVar,
 			helper.NewImportAttribute(ProjectIDVar, helper.ConvertID, false),
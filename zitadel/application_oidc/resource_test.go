@@ -2,6 +2,7 @@ package application_oidc_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -40,6 +41,23 @@ func TestAccAppOIDC(t *testing.T) {
 	)
 }
 
+func TestAccAppOIDCSecretRotation(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_application_oidc")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleName := test_utils.AttributeValue(t, application_oidc.NameVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, exampleName, frame.UniqueResourcesID, 1)
+	projectDep, _ := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	test_utils.RunSecretRotationTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, projectDep},
+		func(trigger string) string {
+			return test_utils.WithSecretRotationTrigger(resourceExample, trigger)
+		},
+		application_oidc.ClientSecretVar,
+	)
+}
+
 func checkRemoteProperty(frame *test_utils.OrgTestFrame, projectId string) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
@@ -1,10 +1,14 @@
 package application_saml
 
 const (
-	AppIDVar       = "app_id"
-	appIDsVar      = "app_ids"
-	ProjectIDVar   = "project_id"
-	NameVar        = "name"
-	nameMethodVar  = "name_method"
-	MetadataXMLVar = "metadata_xml"
+	AppIDVar        = "app_id"
+	appIDsVar       = "app_ids"
+	ProjectIDVar    = "project_id"
+	NameVar         = "name"
+	nameMethodVar   = "name_method"
+	MetadataXMLVar  = "metadata_xml"
+	MetadataURLVar  = "metadata_url"
+	entityIDVar     = "entity_id"
+	acsURLsVar      = "acs_urls"
+	certificatesVar = "certificates"
 )
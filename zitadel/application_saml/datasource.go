@@ -11,18 +11,22 @@ import (
 
 func GetDatasource() *schema.Resource {
 	return &schema.Resource{
-		Description: "Datasource representing a SAML application belonging to a project, with all configuration possibilities.",
+		Description: "Datasource representing a SAML application belonging to a project, with all configuration possibilities. The application is looked up by exactly one of app_id or entity_id. Looking it up by entity_id only finds applications of projects the organization owns, not of granted projects.",
 		Schema: map[string]*schema.Schema{
 			AppIDVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The ID of this resource.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The ID of this resource.",
+				ExactlyOneOf: []string{AppIDVar, entityIDVar},
+				RequiredWith: []string{ProjectIDVar},
 			},
 			helper.OrgIDVar: helper.OrgIDDatasourceField,
 			ProjectIDVar: {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "ID of the project",
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the project, required if the application is looked up by app_id",
 			},
 			NameVar: {
 				Type:        schema.TypeString,
@@ -34,8 +38,33 @@ func GetDatasource() *schema.Resource {
 				Computed:    true,
 				Description: "Metadata as XML file",
 			},
+			MetadataURLVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL from which ZITADEL fetches the metadata of the service provider",
+			},
+			helper.LoginVersionVar: helper.LoginVersionDatasourceField,
+			helper.LoginBaseURIVar: helper.LoginBaseURIDatasourceField,
+			entityIDVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Entity ID of the service provider, parsed from the metadata. The application is looked up by it if no app_id is given by listing the apps of all projects the organization owns, which needs a request per project once per run of the provider. Applications of granted projects are not found",
+			},
+			acsURLsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Assertion consumer service URLs of the service provider, parsed from the metadata",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			certificatesVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Base64 encoded X.509 certificates of the service provider, parsed from the metadata",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
-		ReadContext: read,
+		ReadContext: get,
 	}
 }
 
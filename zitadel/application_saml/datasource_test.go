@@ -20,6 +20,8 @@ func TestAccApplicationSAMLDatasource_ID(t *testing.T) {
 	frame := test_utils.NewOrgTestFrame(t, datasourceName)
 	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
 	exampleID := test_utils.AttributeValue(t, application_saml.AppIDVar, attributes).AsString()
+	// the second block looks the application up by entity_id
+	config = strings.Join(strings.Split(config, "\n")[0:5], "\n")
 	projectDep, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
 	appName := "application_saml_datasource_" + frame.UniqueResourcesID
 	_, appID := application_saml_test_dep.Create(t, frame, projectID, appName)
@@ -35,6 +37,34 @@ func TestAccApplicationSAMLDatasource_ID(t *testing.T) {
 			"project_id": projectID,
 			"app_id":     appID,
 			"name":       appName,
+			"entity_id":  appName,
+			"acs_urls.#": "1",
+			"acs_urls.0": "http://example.com/saml/cas",
+		},
+	)
+}
+
+func TestAccApplicationSAMLDatasource_EntityID(t *testing.T) {
+	datasourceName := "zitadel_application_saml"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, _ := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	config = strings.Join(strings.Split(config, "\n")[6:10], "\n")
+	_, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	appName := "application_saml_datasource_" + frame.UniqueResourcesID
+	_, appID := application_saml_test_dep.Create(t, frame, projectID, appName)
+	config = strings.Replace(config, "http://example.com/saml/metadata", appName, 1)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		nil,
+		map[string]string{
+			"org_id":     frame.OrgID,
+			"project_id": projectID,
+			"app_id":     appID,
+			"name":       appName,
+			"entity_id":  appName,
 		},
 	)
 }
@@ -2,6 +2,8 @@ package application_saml
 
 import (
 	"context"
+	"encoding/xml"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,11 +28,7 @@ func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	_, err = client.RemoveApp(helper.CtxWithOrgID(ctx, d), &management.RemoveAppRequest{
-		ProjectId: d.Get(ProjectIDVar).(string),
-		AppId:     d.Id(),
-	})
-	if err != nil {
+	if err := helper.DeactivateOrRemoveApp(helper.CtxWithOrgID(ctx, d), client, d, d.Get(ProjectIDVar).(string)); err != nil {
 		return diag.Errorf("failed to delete applicationSAML: %v", err)
 	}
 	return nil
@@ -56,20 +54,29 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			AppId:     d.Id(),
 			Name:      d.Get(NameVar).(string),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update application: %v", err)
 		}
 	}
 
-	if d.HasChanges(MetadataXMLVar) {
-		_, err = client.UpdateSAMLAppConfig(helper.CtxWithOrgID(ctx, d), &management.UpdateSAMLAppConfigRequest{
-			ProjectId: projectID,
-			AppId:     d.Id(),
+	if d.HasChanges(MetadataXMLVar, MetadataURLVar, helper.LoginVersionVar, helper.LoginBaseURIVar) {
+		loginVersion, err := helper.GetLoginVersion(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req := &management.UpdateSAMLAppConfigRequest{
+			ProjectId:    projectID,
+			AppId:        d.Id(),
+			LoginVersion: loginVersion,
 			Metadata: &management.UpdateSAMLAppConfigRequest_MetadataXml{
 				MetadataXml: []byte(d.Get(MetadataXMLVar).(string)),
 			},
-		})
-		if err != nil {
+		}
+		if metadataURL := d.Get(MetadataURLVar).(string); metadataURL != "" {
+			req.Metadata = &management.UpdateSAMLAppConfigRequest_MetadataUrl{MetadataUrl: metadataURL}
+		}
+		_, err = client.UpdateSAMLAppConfig(helper.CtxWithOrgID(ctx, d), req)
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update applicationSAML: %v", err)
 		}
 	}
@@ -89,11 +96,34 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	resp, err := client.AddSAMLApp(helper.CtxWithOrgID(ctx, d), &management.AddSAMLAppRequest{
-		ProjectId: d.Get(ProjectIDVar).(string),
-		Name:      d.Get(NameVar).(string),
-		Metadata:  &management.AddSAMLAppRequest_MetadataXml{MetadataXml: []byte(d.Get(MetadataXMLVar).(string))},
-	})
+	if helper.DeactivateOnDestroy(d) {
+		appID, err := helper.ReactivateDeactivatedApp(helper.CtxWithOrgID(ctx, d), clientinfo, client, d.Get(ProjectIDVar).(string), d.Get(NameVar).(string), func(a *app.App) bool { return a.GetSamlConfig() != nil })
+		if err != nil {
+			return diag.Errorf("failed to adopt deactivated applicationSAML: %v", err)
+		}
+		if appID != "" {
+			d.SetId(appID)
+			if diags := update(ctx, d, m); diags.HasError() {
+				return diags
+			}
+			return read(ctx, d, m)
+		}
+	}
+
+	loginVersion, err := helper.GetLoginVersion(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := &management.AddSAMLAppRequest{
+		ProjectId:    d.Get(ProjectIDVar).(string),
+		Name:         d.Get(NameVar).(string),
+		Metadata:     &management.AddSAMLAppRequest_MetadataXml{MetadataXml: []byte(d.Get(MetadataXMLVar).(string))},
+		LoginVersion: loginVersion,
+	}
+	if metadataURL := d.Get(MetadataURLVar).(string); metadataURL != "" {
+		req.Metadata = &management.AddSAMLAppRequest_MetadataUrl{MetadataUrl: metadataURL}
+	}
+	resp, err := client.AddSAMLApp(helper.CtxWithOrgID(ctx, d), req)
 	if err != nil {
 		return diag.Errorf("failed to create applicationSAML: %v", err)
 	}
@@ -124,10 +154,22 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	}
 
 	app := resp.GetApp()
+	samlConfig := app.GetSamlConfig()
+	metadata, err := parseMetadata(samlConfig.GetMetadataXml())
+	if err != nil {
+		return diag.Errorf("failed to parse metadata of applicationSAML: %v", err)
+	}
 	set := map[string]interface{}{
 		helper.OrgIDVar: app.GetDetails().GetResourceOwner(),
 		NameVar:         app.GetName(),
-		MetadataXMLVar:  string(app.GetSamlConfig().GetMetadataXml()),
+		MetadataXMLVar:  string(samlConfig.GetMetadataXml()),
+		MetadataURLVar:  samlConfig.GetMetadataUrl(),
+		entityIDVar:     metadata.EntityID,
+		acsURLsVar:      metadata.acsURLs(),
+		certificatesVar: metadata.certificates(),
+	}
+	for k, v := range helper.LoginVersionState(samlConfig.GetLoginVersion()) {
+		set[k] = v
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
@@ -138,6 +180,50 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	return nil
 }
 
+// get reads the application by app_id or looks it up by its entity ID in all projects of the organization
+func get(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started get")
+
+	if _, ok := d.GetOk(AppIDVar); ok {
+		return read(ctx, d, m)
+	}
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entityID := d.Get(entityIDVar).(string)
+	projectID, found, err := helper.FindApp(ctx, client, d.Get(helper.OrgIDVar).(string), func(a *app.App) bool {
+		if a.GetSamlConfig() == nil {
+			return false
+		}
+		metadata, err := parseMetadata(a.GetSamlConfig().GetMetadataXml())
+		return err == nil && metadata.EntityID == entityID
+	})
+	if err != nil {
+		return diag.Errorf("failed to get applicationSAML by entity ID: %v", err)
+	}
+	if found == nil {
+		return diag.Errorf("no SAML application found with entity ID %s", entityID)
+	}
+	set := map[string]interface{}{
+		AppIDVar:     found.GetId(),
+		ProjectIDVar: projectID,
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of applicationSAML: %v", k, err)
+		}
+	}
+	return read(ctx, d, m)
+}
+
 func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started list")
 	name := d.Get(NameVar).(string)
@@ -179,3 +265,44 @@ func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	d.SetId("-")
 	return diag.FromErr(d.Set(appIDsVar, ids))
 }
+
+type spMetadata struct {
+	EntityID        string `xml:"entityID,attr"`
+	SPSSODescriptor struct {
+		KeyDescriptors []struct {
+			Certificate string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"KeyDescriptor"`
+		AssertionConsumerServices []struct {
+			Location string `xml:"Location,attr"`
+		} `xml:"AssertionConsumerService"`
+	} `xml:"SPSSODescriptor"`
+}
+
+// parseMetadata parses the service provider details from the metadata, namespaces are ignored
+func parseMetadata(metadataXML []byte) (*spMetadata, error) {
+	metadata := new(spMetadata)
+	if len(metadataXML) == 0 {
+		return metadata, nil
+	}
+	return metadata, xml.Unmarshal(metadataXML, metadata)
+}
+
+func (m *spMetadata) acsURLs() []string {
+	urls := make([]string, 0, len(m.SPSSODescriptor.AssertionConsumerServices))
+	for _, acs := range m.SPSSODescriptor.AssertionConsumerServices {
+		urls = append(urls, acs.Location)
+	}
+	return urls
+}
+
+func (m *spMetadata) certificates() []string {
+	certificates := make([]string, 0, len(m.SPSSODescriptor.KeyDescriptors))
+	for _, key := range m.SPSSODescriptor.KeyDescriptors {
+		// certificates are often wrapped over multiple lines
+		certificate := strings.Join(strings.Fields(key.Certificate), "")
+		if certificate != "" {
+			certificates = append(certificates, certificate)
+		}
+	}
+	return certificates
+}
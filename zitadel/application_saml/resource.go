@@ -10,7 +10,8 @@ func GetResource() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource representing a SAML application belonging to a project, with all configuration possibilities.",
 		Schema: map[string]*schema.Schema{
-			helper.OrgIDVar: helper.OrgIDResourceField,
+			helper.OrgIDVar:     helper.OrgIDResourceField,
+			helper.OnDestroyVar: helper.OnDestroyResourceField,
 			ProjectIDVar: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -23,10 +24,39 @@ func GetResource() *schema.Resource {
 				Description: "Name of the application",
 			},
 			MetadataXMLVar: {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				Computed:              true,
+				Description:           "Metadata as XML file. Equivalent documents, for example with different whitespace or attribute order, don't produce a diff",
+				Sensitive:             true,
+				ExactlyOneOf:          []string{MetadataXMLVar, MetadataURLVar},
+				DiffSuppressFunc:      helper.SuppressEquivalentXMLDiff,
+				DiffSuppressOnRefresh: true,
+			},
+			MetadataURLVar: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "URL from which ZITADEL fetches the metadata of the service provider",
+				ExactlyOneOf: []string{MetadataXMLVar, MetadataURLVar},
+			},
+			helper.LoginVersionVar: helper.LoginVersionResourceField,
+			helper.LoginBaseURIVar: helper.LoginBaseURIResourceField,
+			entityIDVar: {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Metadata as XML file",
-				Sensitive:   true,
+				Computed:    true,
+				Description: "Entity ID of the service provider, parsed from the metadata",
+			},
+			acsURLsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Assertion consumer service URLs of the service provider, parsed from the metadata",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			certificatesVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Base64 encoded X.509 certificates of the service provider, parsed from the metadata",
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 		},
 		DeleteContext: delete,
@@ -2,6 +2,7 @@ package application_saml_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -18,6 +19,8 @@ func TestAccAppSAML(t *testing.T) {
 	frame := test_utils.NewOrgTestFrame(t, "zitadel_application_saml")
 	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
 	exampleProperty := test_utils.AttributeValue(t, application_saml.NameVar, exampleAttributes).AsString()
+	// the example fetching the metadata from a URL is cut off, because the URL is not reachable in acceptance tests
+	resourceExample = strings.Join(strings.Split(resourceExample, "\n")[0:7], "\n")
 	projectDep, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
 	test_utils.RunLifecyleTest(
 		t,
@@ -27,7 +27,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultDomainClaimedMessageTextResource{}
+	_ resource.Resource               = &defaultDomainClaimedMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &defaultDomainClaimedMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -91,6 +92,10 @@ func (r *defaultDomainClaimedMessageTextResource) Configure(_ context.Context, r
 	r.clientInfo = clientInfo
 }
 
+func (r *defaultDomainClaimedMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *defaultDomainClaimedMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -466,4 +471,4 @@ func getStateAttrsFromObject(ctx context.Context, obj types.Object, diags diag.D
 	}
 
 	return ""
-}
\ No newline at end of file
+}
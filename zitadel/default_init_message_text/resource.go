@@ -27,7 +27,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultInitMessageTextResource{}
+	_ resource.Resource               = &defaultInitMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &defaultInitMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -102,6 +103,10 @@ func (r *defaultInitMessageTextResource) Configure(_ context.Context, req resour
 	r.clientInfo = clientInfo
 }
 
+func (r *defaultInitMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *defaultInitMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -467,4 +472,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
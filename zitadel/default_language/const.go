@@ -0,0 +1,5 @@
+package default_language
+
+const (
+	LanguageVar = "language"
+)
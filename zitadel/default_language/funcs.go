@@ -0,0 +1,77 @@
+package default_language
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Warn(ctx, "default language cannot be deleted")
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.SetDefaultLanguage(ctx, &admin.SetDefaultLanguageRequest{
+		Language: d.Get(LanguageVar).(string),
+	})
+	if helper.IgnorePreconditionError(err) != nil {
+		return diag.Errorf("failed to set default language: %v", err)
+	}
+	if resp != nil {
+		d.SetId(resp.GetDetails().GetResourceOwner())
+		return nil
+	}
+	return read(ctx, d, m)
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetDefaultLanguage(ctx, &admin.GetDefaultLanguageRequest{})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get default language")
+	}
+	if err := d.Set(LanguageVar, resp.GetLanguage()); err != nil {
+		return diag.Errorf("failed to set %s of default language: %v", LanguageVar, err)
+	}
+
+	// The default language response doesn't contain any details, so we use the instance ID as the resources ID.
+	instance, err := client.GetMyInstance(ctx, &admin.GetMyInstanceRequest{})
+	if err != nil {
+		return diag.Errorf("failed to get instance: %v", err)
+	}
+	d.SetId(instance.GetInstance().GetId())
+	return nil
+}
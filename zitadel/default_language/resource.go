@@ -0,0 +1,25 @@
+package default_language
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the default language of an instance. The language has to be allowed by the instance restrictions when it is applied, so make the resource depend on zitadel_instance_restrictions if both are changed in the same apply.",
+		Schema: map[string]*schema.Schema{
+			LanguageVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Default language of the instance, e.g. en",
+			},
+		},
+		CreateContext: update,
+		UpdateContext: update,
+		DeleteContext: delete,
+		ReadContext:   read,
+		Importer:      helper.ImportWithEmptyID(),
+	}
+}
@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultLoginTextsResource{}
+	_ resource.Resource               = &defaultLoginTextsResource{}
+	_ resource.ResourceWithModifyPlan = &defaultLoginTextsResource{}
 )
 
 func New() resource.Resource {
@@ -80,6 +81,10 @@ func (r *defaultLoginTextsResource) Configure(_ context.Context, req resource.Co
 	r.clientInfo = clientInfo
 }
 
+func (r *defaultLoginTextsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *defaultLoginTextsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -379,4 +384,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
@@ -23,7 +23,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultPasswordChangeMessageTextResource{}
+	_ resource.Resource               = &defaultPasswordChangeMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &defaultPasswordChangeMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -109,6 +110,10 @@ func (r *defaultPasswordChangeMessageTextResource) Configure(_ context.Context,
 	r.clientInfo = req.ProviderData.(*helper.ClientInfo)
 }
 
+func (r *defaultPasswordChangeMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *defaultPasswordChangeMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -297,4 +302,4 @@ func getStateAttrs(ctx context.Context, state tfsdk.State, diag diag.Diagnostics
 		return ""
 	}
 	return language
-}
\ No newline at end of file
+}
@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultPasswordResetMessageTextResource{}
+	_ resource.Resource               = &defaultPasswordResetMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &defaultPasswordResetMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -106,6 +107,10 @@ func (r *defaultPasswordResetMessageTextResource) Configure(_ context.Context, r
 	r.clientInfo = clientInfo
 }
 
+func (r *defaultPasswordResetMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *defaultPasswordResetMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -413,4 +418,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultPasswordlessRegistrationMessageTextResource{}
+	_ resource.Resource               = &defaultPasswordlessRegistrationMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &defaultPasswordlessRegistrationMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -106,6 +107,10 @@ func (r *defaultPasswordlessRegistrationMessageTextResource) Configure(_ context
 	r.clientInfo = clientInfo
 }
 
+func (r *defaultPasswordlessRegistrationMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *defaultPasswordlessRegistrationMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -413,4 +418,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultVerifyEmailMessageTextResource{}
+	_ resource.Resource               = &defaultVerifyEmailMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &defaultVerifyEmailMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -106,6 +107,10 @@ func (r *defaultVerifyEmailMessageTextResource) Configure(_ context.Context, req
 	r.clientInfo = clientInfo
 }
 
+func (r *defaultVerifyEmailMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *defaultVerifyEmailMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -413,4 +418,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
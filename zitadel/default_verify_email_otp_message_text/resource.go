@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultVerifyEmailOTPMessageTextResource{}
+	_ resource.Resource               = &defaultVerifyEmailOTPMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &defaultVerifyEmailOTPMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -100,6 +101,10 @@ func (r *defaultVerifyEmailOTPMessageTextResource) Configure(_ context.Context,
 	r.clientInfo = clientInfo
 }
 
+func (r *defaultVerifyEmailOTPMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 type defaultVerifyEmailOtpMessageTextModel struct {
 	OrgID types.String `tfsdk:"org_id"`
 	ID    types.String `tfsdk:"id"`
@@ -413,4 +418,4 @@ func getStateAttrsFromObject(ctx context.Context, obj types.Object, diags diag.D
 	}
 
 	return ""
-}
\ No newline at end of file
+}
@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultVerifyPhoneMessageTextResource{}
+	_ resource.Resource               = &defaultVerifyPhoneMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &defaultVerifyPhoneMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -89,6 +90,10 @@ func (r *defaultVerifyPhoneMessageTextResource) Configure(_ context.Context, req
 	r.clientInfo = clientInfo
 }
 
+func (r *defaultVerifyPhoneMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *defaultVerifyPhoneMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -388,4 +393,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
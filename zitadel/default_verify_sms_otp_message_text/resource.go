@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &defaultVerifySMSOTPMessageTextResource{}
+	_ resource.Resource               = &defaultVerifySMSOTPMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &defaultVerifySMSOTPMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -89,6 +90,10 @@ func (r *defaultVerifySMSOTPMessageTextResource) Configure(_ context.Context, re
 	r.clientInfo = clientInfo
 }
 
+func (r *defaultVerifySMSOTPMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *defaultVerifySMSOTPMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -388,4 +393,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
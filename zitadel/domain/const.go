@@ -1,8 +1,11 @@
 package domain
 
 const (
-	NameVar           = "name"
-	isVerifiedVar     = "is_verified"
-	isPrimaryVar      = "is_primary"
-	validationTypeVar = "validation_type"
+	NameVar                = "name"
+	isVerifiedVar          = "is_verified"
+	isPrimaryVar           = "is_primary"
+	validationTypeVar      = "validation_type"
+	validationTokenVar     = "validation_token"
+	validationDNSRecordVar = "validation_dns_record"
+	validationURLVar       = "validation_url"
 )
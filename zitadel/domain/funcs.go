@@ -2,11 +2,13 @@ package domain
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
@@ -79,6 +81,11 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			return diag.Errorf("failed to set domain primary: %v", err)
 		}
 	}
+	if _, ok := d.GetOk(validationTypeVar); ok {
+		if err := generateValidation(ctx, client, d); err != nil {
+			return diag.Errorf("failed to generate domain validation: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -105,6 +112,43 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			}
 		}
 	}
+	if d.HasChange(validationTypeVar) {
+		if err := generateValidation(ctx, client, d); err != nil {
+			return diag.Errorf("failed to generate domain validation: %v", err)
+		}
+	}
+	return nil
+}
+
+// generateValidation generates a validation token for the configured validation type.
+// Verified domains can't be validated anymore, so precondition errors are ignored.
+func generateValidation(ctx context.Context, client *mgmt.Client, d *schema.ResourceData) error {
+	validationType := org.DomainValidationType(org.DomainValidationType_value[d.Get(validationTypeVar).(string)])
+	if validationType == org.DomainValidationType_DOMAIN_VALIDATION_TYPE_UNSPECIFIED {
+		return nil
+	}
+	resp, err := client.GenerateOrgDomainValidation(helper.CtxWithOrgID(ctx, d), &management.GenerateOrgDomainValidationRequest{
+		Domain: d.Id(),
+		Type:   validationType,
+	})
+	if err != nil {
+		return helper.IgnorePreconditionError(err)
+	}
+	set := map[string]interface{}{
+		validationTokenVar:     resp.GetToken(),
+		validationDNSRecordVar: "",
+		validationURLVar:       "",
+	}
+	if validationType == org.DomainValidationType_DOMAIN_VALIDATION_TYPE_DNS {
+		set[validationDNSRecordVar] = resp.GetUrl()
+	} else {
+		set[validationURLVar] = resp.GetUrl()
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("failed to set %s of domain: %v", k, err)
+		}
+	}
 	return nil
 }
 
@@ -143,11 +187,16 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	if len(resp.Result) == 1 {
 		domain := resp.Result[0]
 		set := map[string]interface{}{
-			NameVar:           domain.GetDomainName(),
-			helper.OrgIDVar:   domain.GetOrgId(),
-			isVerifiedVar:     domain.GetIsVerified(),
-			isPrimaryVar:      domain.GetIsPrimary(),
-			validationTypeVar: domain.GetValidationType().Number(),
+			NameVar:         domain.GetDomainName(),
+			helper.OrgIDVar: domain.GetOrgId(),
+			isVerifiedVar:   domain.GetIsVerified(),
+			isPrimaryVar:    domain.GetIsPrimary(),
+		}
+		// the validation type is only known after a validation was generated
+		if validationType := domain.GetValidationType(); validationType != org.DomainValidationType_DOMAIN_VALIDATION_TYPE_UNSPECIFIED {
+			set[validationTypeVar] = validationType.String()
+		} else if _, ok := org.DomainValidationType_value[d.Get(validationTypeVar).(string)]; !ok {
+			set[validationTypeVar] = ""
 		}
 		for k, v := range set {
 			if err := d.Set(k, v); err != nil {
@@ -1,14 +1,25 @@
 package domain
 
 import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
 
 func GetResource() *schema.Resource {
 	return &schema.Resource{
-		Description: "Resource representing a domain of the organization.",
+		Description:   "Resource representing a domain of the organization.",
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeV0,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			helper.OrgIDVar: helper.OrgIDResourceField,
 			NameVar: {
@@ -29,9 +40,28 @@ func GetResource() *schema.Resource {
 				Default:     false,
 			},
 			validationTypeVar: {
-				Type:        schema.TypeInt,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Validation type, generates a validation token for the domain if it is not verified yet" + helper.DescriptionEnumValuesList(org.DomainValidationType_name),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return helper.EnumValueValidation(validationTypeVar, value, org.DomainValidationType_value)
+				},
+			},
+			validationTokenVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Token which has to be published to verify the domain",
+			},
+			validationDNSRecordVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the TXT record which has to contain the validation token, if the validation type is DNS",
+			},
+			validationURLVar: {
+				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Validation type",
+				Description: "URL under which the validation token has to be served, if the validation type is HTTP",
 			},
 		},
 		ReadContext:   read,
@@ -34,6 +34,7 @@ func TestAccDomain(t *testing.T) {
 			test_utils.ImportResourceId(frame.BaseTestFrame),
 			test_utils.ImportOrgId(frame),
 		),
+		"validation_token", "validation_dns_record", "validation_url",
 	)
 }
 
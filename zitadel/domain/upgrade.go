@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+// resourceV0 is the schema in which validation_type was the number of the validation type
+func resourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDResourceField,
+			NameVar: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			isVerifiedVar: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			isPrimaryVar: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			validationTypeVar: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// upgradeV0 replaces the number of the validation type with its name, an unspecified validation type becomes empty
+func upgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	var number int32
+	switch value := rawState[validationTypeVar].(type) {
+	case float64:
+		number = int32(value)
+	case int:
+		number = int32(value)
+	}
+	rawState[validationTypeVar] = ""
+	if org.DomainValidationType(number) != org.DomainValidationType_DOMAIN_VALIDATION_TYPE_UNSPECIFIED {
+		rawState[validationTypeVar] = org.DomainValidationType_name[number]
+	}
+	return rawState, nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUpgradeV0(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{{
+		name:  "unspecified becomes empty",
+		value: float64(0),
+		want:  "",
+	}, {
+		name:  "http becomes its name",
+		value: float64(1),
+		want:  "DOMAIN_VALIDATION_TYPE_HTTP",
+	}, {
+		name:  "dns becomes its name",
+		value: float64(2),
+		want:  "DOMAIN_VALIDATION_TYPE_DNS",
+	}, {
+		name: "missing becomes empty",
+		want: "",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawState := map[string]interface{}{NameVar: "example.com"}
+			if tt.value != nil {
+				rawState[validationTypeVar] = tt.value
+			}
+			got, err := upgradeV0(context.Background(), rawState, nil)
+			if err != nil {
+				t.Fatalf("upgradeV0() error = %v", err)
+			}
+			if got[validationTypeVar] != tt.want {
+				t.Errorf("upgradeV0() %s = %v, want %v", validationTypeVar, got[validationTypeVar], tt.want)
+			}
+			if got[NameVar] != "example.com" {
+				t.Errorf("upgradeV0() changed %s to %v", NameVar, got[NameVar])
+			}
+		})
+	}
+}
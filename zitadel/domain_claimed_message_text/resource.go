@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &domainClaimedMessageTextResource{}
+	_ resource.Resource               = &domainClaimedMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &domainClaimedMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -78,6 +79,10 @@ func (r *domainClaimedMessageTextResource) Configure(_ context.Context, req reso
 	r.clientInfo = clientInfo
 }
 
+func (r *domainClaimedMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *domainClaimedMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	orgID, language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -396,4 +401,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
@@ -0,0 +1,6 @@
+package domain_verification
+
+const (
+	DomainVar     = "domain"
+	isVerifiedVar = "is_verified"
+)
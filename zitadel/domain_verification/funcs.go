@@ -0,0 +1,137 @@
+package domain_verification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+	// a verified domain can't be unverified, so the resource is only removed from the state
+	return nil
+}
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get(DomainVar).(string)
+	domain, err := getDomain(ctx, client, d, name)
+	if err != nil {
+		return diag.Errorf("failed to get domain: %v", err)
+	}
+	if domain == nil {
+		return diag.Errorf("domain %s not found", name)
+	}
+	if !domain.GetIsVerified() {
+		attempts := 0
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+			attempts++
+			_, err := client.ValidateOrgDomain(helper.CtxWithOrgID(ctx, d), &management.ValidateOrgDomainRequest{
+				Domain: name,
+			})
+			switch status.Code(err) {
+			case codes.OK:
+				return nil
+			case codes.PermissionDenied, codes.Unauthenticated, codes.NotFound:
+				return retry.NonRetryableError(err)
+			default:
+				// ZITADEL returns InvalidArgument or FailedPrecondition as long as the published token isn't visible,
+				// for example because of DNS propagation
+				tflog.Debug(ctx, "domain not verified yet", map[string]interface{}{"domain": name, "error": err.Error()})
+				return retry.RetryableError(err)
+			}
+		})
+		if err != nil {
+			return diag.Errorf("failed to verify domain after %d attempts: %v", attempts, err)
+		}
+	}
+	d.SetId(name)
+	return read(ctx, d, m)
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain, err := getDomain(ctx, client, d, d.Id())
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get domain: %v", err)
+	}
+	// the verification has to be done again if the domain was removed
+	if domain == nil || !domain.GetIsVerified() {
+		d.SetId("")
+		return nil
+	}
+	set := map[string]interface{}{
+		DomainVar:       domain.GetDomainName(),
+		helper.OrgIDVar: domain.GetOrgId(),
+		isVerifiedVar:   domain.GetIsVerified(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of domain verification: %v", k, err)
+		}
+	}
+	d.SetId(domain.GetDomainName())
+	return nil
+}
+
+func getDomain(ctx context.Context, client *mgmt.Client, d *schema.ResourceData, name string) (*org.Domain, error) {
+	resp, err := client.ListOrgDomains(helper.CtxWithOrgID(ctx, d), &management.ListOrgDomainsRequest{
+		Queries: []*org.DomainSearchQuery{{
+			Query: &org.DomainSearchQuery_DomainNameQuery{
+				DomainNameQuery: &org.DomainNameQuery{
+					Name:   name,
+					Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch len(resp.GetResult()) {
+	case 0:
+		return nil, nil
+	case 1:
+		return resp.GetResult()[0], nil
+	default:
+		return nil, fmt.Errorf("found %d domains with the name %s", len(resp.GetResult()), name)
+	}
+}
@@ -0,0 +1,42 @@
+package domain_verification
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource verifying a domain of the organization. " +
+			"The validation token of the domain has to be published as DNS record or HTTP file before, for example by a resource of a DNS provider. " +
+			"The verification is retried until it succeeds or the create timeout is reached. " +
+			"Destroying the resource doesn't revert the verification.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDResourceField,
+			DomainVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the domain to verify",
+				ForceNew:    true,
+			},
+			isVerifiedVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is domain verified",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		ReadContext:   read,
+		CreateContext: create,
+		DeleteContext: delete,
+		Importer: helper.ImportWithAttributes(
+			helper.NewImportAttribute(DomainVar, helper.ConvertNonEmpty, false),
+			helper.ImportOptionalOrgAttribute,
+		),
+	}
+}
@@ -0,0 +1,118 @@
+package domain_verification_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+)
+
+func TestAccDomainVerification(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_domain_verification")
+	otherFrame := frame.AnotherOrg(t, "domain-verification-org-"+frame.UniqueResourcesID)
+	resourceExample, _ := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := "verification." + frame.UniqueResourcesID + ".127.0.0.1.sslip.io"
+	updatedProperty := "updated.verification." + frame.UniqueResourcesID + ".127.0.0.1.sslip.io"
+	test_utils.RunLifecyleTest(
+		t,
+		otherFrame.BaseTestFrame,
+		[]string{otherFrame.AsOrgDefaultDependency},
+		func(property, _ string) string {
+			// domains are verified immediately if the organization doesn't validate domains
+			return fmt.Sprintf(`
+resource "zitadel_domain_policy" "default" {
+  org_id                                      = data.zitadel_org.default.id
+  user_login_must_be_domain                   = false
+  validate_org_domains                        = false
+  smtp_sender_address_matches_instance_domain = true
+}
+
+resource "zitadel_domain" "default" {
+  org_id     = data.zitadel_org.default.id
+  name       = "%s"
+  depends_on = [zitadel_domain_policy.default]
+}
+
+%s`, property, resourceExample)
+		},
+		exampleProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(otherFrame),
+		regexp.MustCompile(fmt.Sprintf(`^%s$|^%s$`, regexp.QuoteMeta(exampleProperty), regexp.QuoteMeta(updatedProperty))),
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(otherFrame), updatedProperty),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportResourceId(otherFrame.BaseTestFrame),
+			test_utils.ImportOrgId(otherFrame),
+		),
+		"timeouts",
+	)
+}
+
+func TestAccDomainVerificationValidateOrgDomains(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_domain_verification")
+	otherFrame := frame.AnotherOrg(t, "domain-verification-validate-org-"+frame.UniqueResourcesID)
+	resourceExample, _ := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	// no validation token is published, so the verification is retried until the timeout
+	resourceExample = strings.Replace(resourceExample, `create = "15m"`, `create = "20s"`, 1)
+	test_utils.RunStepsTest(
+		t,
+		otherFrame.BaseTestFrame,
+		[]string{otherFrame.AsOrgDefaultDependency},
+		nil,
+		resource.TestStep{
+			Config: fmt.Sprintf(`
+resource "zitadel_domain_policy" "default" {
+  org_id                                      = data.zitadel_org.default.id
+  user_login_must_be_domain                   = false
+  validate_org_domains                        = true
+  smtp_sender_address_matches_instance_domain = true
+}
+
+resource "zitadel_domain" "default" {
+  org_id          = data.zitadel_org.default.id
+  name            = "%s"
+  validation_type = "DOMAIN_VALIDATION_TYPE_HTTP"
+  depends_on      = [zitadel_domain_policy.default]
+}
+
+%s`, "unverified."+frame.UniqueResourcesID+".127.0.0.1.sslip.io", resourceExample),
+			ExpectError: regexp.MustCompile(`failed to verify domain after ([2-9]|\d{2,}) attempts`),
+		},
+	)
+}
+
+func checkRemoteProperty(frame *test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			remoteResource, err := frame.ListOrgDomains(frame, &management.ListOrgDomainsRequest{
+				Queries: []*org.DomainSearchQuery{{
+					Query: &org.DomainSearchQuery_DomainNameQuery{
+						DomainNameQuery: &org.DomainNameQuery{
+							Name:   expect,
+							Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+						},
+					},
+				}},
+			})
+			if err != nil {
+				return err
+			}
+			if len(remoteResource.GetResult()) == 0 {
+				return fmt.Errorf("expected to find %s, but didn't: %w", expect, test_utils.ErrNotFound)
+			}
+			if !remoteResource.GetResult()[0].GetIsVerified() {
+				return fmt.Errorf("expected %s to be verified, but it isn't", expect)
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package email_provider
+
+const (
+	emailProvidersVar        = "email_providers"
+	activeEmailProviderIDVar = "active_email_provider_id"
+	idVar                    = "id"
+	descriptionVar           = "description"
+	stateVar                 = "state"
+	activeVar                = "active"
+	typeVar                  = "type"
+	hostVar                  = "host"
+	senderAddressVar         = "sender_address"
+	endpointVar              = "endpoint"
+
+	typeSMTP = "smtp"
+	typeHTTP = "http"
+)
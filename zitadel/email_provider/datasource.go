@@ -0,0 +1,71 @@
+package email_provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func ListDatasources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing all email providers of an instance, including SMTP configurations and HTTP email providers.",
+		Schema: map[string]*schema.Schema{
+			activeEmailProviderIDVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the active email provider, empty if no provider is active",
+			},
+			emailProvidersVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All email providers of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						idVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the email provider",
+						},
+						descriptionVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the email provider",
+						},
+						stateVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the email provider" + helper.DescriptionEnumValuesList(settings.EmailProviderState_name),
+						},
+						activeVar: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the email provider is the active one",
+						},
+						typeVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the email provider, either " + typeSMTP + " or " + typeHTTP,
+						},
+						hostVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Host and port address of the SMTP server, only set for SMTP providers",
+						},
+						senderAddressVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Address used to send emails, only set for SMTP providers",
+						},
+						endpointVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Http endpoint which is used to send the emails, only set for HTTP providers",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: list,
+	}
+}
@@ -0,0 +1,62 @@
+package email_provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ListEmailProviders(ctx, &admin.ListEmailProvidersRequest{})
+	if err != nil {
+		return diag.Errorf("failed to list email providers: %v", err)
+	}
+
+	activeID := ""
+	providers := make([]map[string]interface{}, len(resp.GetResult()))
+	for i, provider := range resp.GetResult() {
+		active := provider.GetState() == settings.EmailProviderState_EMAIL_PROVIDER_ACTIVE
+		if active {
+			activeID = provider.GetId()
+		}
+		providers[i] = map[string]interface{}{
+			idVar:          provider.GetId(),
+			descriptionVar: provider.GetDescription(),
+			stateVar:       provider.GetState().String(),
+			activeVar:      active,
+		}
+		if smtp := provider.GetSmtp(); smtp != nil {
+			providers[i][typeVar] = typeSMTP
+			providers[i][hostVar] = smtp.GetHost()
+			providers[i][senderAddressVar] = smtp.GetSenderAddress()
+		}
+		if http := provider.GetHttp(); http != nil {
+			providers[i][typeVar] = typeHTTP
+			providers[i][endpointVar] = http.GetEndpoint()
+		}
+	}
+	// If the ID is blank, the datasource is deleted and not usable.
+	d.SetId("-")
+	if err := d.Set(activeEmailProviderIDVar, activeID); err != nil {
+		return diag.Errorf("failed to set %s: %v", activeEmailProviderIDVar, err)
+	}
+	return diag.FromErr(d.Set(emailProvidersVar, providers))
+}
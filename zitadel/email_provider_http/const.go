@@ -0,0 +1,9 @@
+package email_provider_http
+
+const (
+	IDVar          = "id"
+	EndpointVar    = "endpoint"
+	DescriptionVar = "description"
+	SetActiveVar   = "set_active"
+	SigningKeyVar  = "signing_key"
+)
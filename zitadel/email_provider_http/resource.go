@@ -0,0 +1,42 @@
+package email_provider_http
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the HTTP email provider configuration of an instance, which posts the emails to a webhook.",
+		Schema: map[string]*schema.Schema{
+			EndpointVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Http endpoint which is used to send the emails.",
+			},
+			DescriptionVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the email provider.",
+			},
+			SetActiveVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Set the email provider as active after creating/updating. Only one email provider of the instance can be active, activating this provider deactivates the currently active one. Setting it to false deactivates the provider, omitting it leaves the activation as it is. The state always reflects whether the provider is active.",
+			},
+			SigningKeyVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Key used to sign the requests to the endpoint, only returned on creation.",
+			},
+		},
+		CreateContext: create,
+		DeleteContext: delete,
+		ReadContext:   read,
+		UpdateContext: update,
+		Importer:      helper.ImportWithIDAndOptionalSecret(IDVar, SigningKeyVar),
+	}
+}
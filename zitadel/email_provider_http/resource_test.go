@@ -0,0 +1,116 @@
+package email_provider_http_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/email_provider_http"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+)
+
+func TestAccEmailProviderHTTP(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_email_provider_http")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, email_provider_http.EndpointVar, exampleAttributes).AsString()
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, "https://relay.example.com/test",
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(*frame), ""),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportResourceId(frame.BaseTestFrame),
+		),
+		email_provider_http.SigningKeyVar,
+	)
+}
+
+func TestAccEmailProviderHTTPSetActive(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_email_provider_http")
+	resourceExample, _ := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleSetActive := "set_active  = false"
+	activeConfig := strings.Replace(resourceExample, exampleSetActive, "set_active  = true", 1)
+	omittedConfig := strings.Replace(resourceExample, exampleSetActive, "", 1)
+	var id string
+	test_utils.RunStepsTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(*frame), ""),
+		resource.TestStep{ // Check the provider is activated
+			Config: activeConfig,
+			Check: func(state *terraform.State) error {
+				id = frame.State(state).ID
+				return test_utils.CheckAMinute(checkRemoteActive(*frame, true))(state)
+			},
+		},
+		resource.TestStep{ // Check deactivating the provider outside of terraform has a diff
+			PreConfig:          deactivate(t, *frame, &id),
+			Config:             activeConfig,
+			ExpectNonEmptyPlan: true,
+			PlanOnly:           true,
+		},
+		resource.TestStep{ // Check the provider is activated again
+			Config: activeConfig,
+			Check:  test_utils.CheckAMinute(checkRemoteActive(*frame, true)),
+		},
+		resource.TestStep{ // Check omitting set_active leaves a provider deactivated outside of terraform inactive
+			PreConfig: deactivate(t, *frame, &id),
+			Config:    omittedConfig,
+			Check:     test_utils.CheckAMinute(checkRemoteActive(*frame, false)),
+		},
+		resource.TestStep{ // Check setting set_active to false keeps the provider inactive
+			Config: resourceExample,
+			Check:  test_utils.CheckAMinute(checkRemoteActive(*frame, false)),
+		},
+	)
+}
+
+func deactivate(t *testing.T, frame test_utils.InstanceTestFrame, id *string) func() {
+	return func() {
+		if _, err := frame.DeactivateEmailProvider(frame, &admin.DeactivateEmailProviderRequest{Id: *id}); err != nil {
+			t.Fatalf("deactivating email provider failed: %v", err)
+		}
+	}
+}
+
+func checkRemoteActive(frame test_utils.InstanceTestFrame, expect bool) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resp, err := frame.GetEmailProviderById(frame, &admin.GetEmailProviderByIdRequest{Id: frame.State(state).ID})
+		if err != nil {
+			return fmt.Errorf("getting email provider failed: %w", err)
+		}
+		if actual := resp.GetConfig().GetState() == settings.EmailProviderState_EMAIL_PROVIDER_ACTIVE; actual != expect {
+			return fmt.Errorf("expected active to be %t, but got %t", expect, actual)
+		}
+		return nil
+	}
+}
+
+func checkRemoteProperty(frame test_utils.InstanceTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.GetEmailProviderById(frame, &admin.GetEmailProviderByIdRequest{Id: frame.State(state).ID})
+			if err != nil {
+				return fmt.Errorf("getting email provider failed: %w", err)
+			}
+			actual := resp.GetConfig().GetHttp().GetEndpoint()
+			if actual != expect {
+				return fmt.Errorf("expected %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package granted_projects
+
+const (
+	GrantedProjectsVar  = "granted_projects"
+	NameVar             = "name"
+	nameMethodVar       = "name_method"
+	projectIDVar        = "project_id"
+	ProjectGrantIDVar   = "project_grant_id"
+	projectNameVar      = "project_name"
+	projectOwnerIDVar   = "project_owner_id"
+	projectOwnerNameVar = "project_owner_name"
+	roleKeysVar         = "role_keys"
+	stateVar            = "state"
+	listPageSize        = 1000
+)
@@ -0,0 +1,79 @@
+package granted_projects
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing the projects other organizations granted to the organization, including the granted roles, optionally filtered by project name.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDDatasourceField,
+			NameVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the granted projects",
+			},
+			nameMethodVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Method for querying granted projects by name" + helper.DescriptionEnumValuesList(object.TextQueryMethod_name),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return helper.EnumValueValidation(nameMethodVar, value, object.TextQueryMethod_value)
+				},
+				Default: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS_IGNORE_CASE.String(),
+			},
+			GrantedProjectsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Projects granted to the organization which match the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						projectIDVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the project",
+						},
+						ProjectGrantIDVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the project grant, for example used for user grants",
+						},
+						projectNameVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the project",
+						},
+						projectOwnerIDVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the organization owning the project",
+						},
+						projectOwnerNameVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the organization owning the project",
+						},
+						roleKeysVar: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of roles granted",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						stateVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the project grant",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: list,
+	}
+}
@@ -0,0 +1,52 @@
+package granted_projects_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/granted_projects"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project/project_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_role/project_role_test_dep"
+)
+
+func TestAccGrantedProjectsDatasource_Name(t *testing.T) {
+	datasourceName := "zitadel_granted_projects"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	exampleName := test_utils.AttributeValue(t, granted_projects.NameVar, attributes).AsString()
+	// the user grant is cut off, because it depends on a user
+	config = strings.Join(strings.Split(config, "\n")[0:4], "\n")
+	projectName := exampleName + "-" + frame.UniqueResourcesID
+	config = strings.Replace(config, exampleName, projectName, 1)
+	_, projectID := project_test_dep.Create(t, frame, projectName)
+	roleKey := "granted"
+	project_role_test_dep.Create(t, frame, projectID, roleKey)
+	grantedFrame := frame.AnotherOrg(t, "granted-projects-"+frame.UniqueResourcesID)
+	grant, err := frame.AddProjectGrant(frame, &management.AddProjectGrantRequest{
+		ProjectId:    projectID,
+		GrantedOrgId: grantedFrame.OrgID,
+		RoleKeys:     []string{roleKey},
+	})
+	if err != nil {
+		t.Fatalf("failed to add project grant: %v", err)
+	}
+	test_utils.RunDatasourceTest(
+		t,
+		grantedFrame.BaseTestFrame,
+		config,
+		[]string{grantedFrame.AsOrgDefaultDependency},
+		nil,
+		map[string]string{
+			"granted_projects.#":                  "1",
+			"granted_projects.0.project_id":       projectID,
+			"granted_projects.0.project_grant_id": grant.GetGrantId(),
+			"granted_projects.0.project_name":     projectName,
+			"granted_projects.0.project_owner_id": frame.OrgID,
+			"granted_projects.0.role_keys.#":      "1",
+			"granted_projects.0.role_keys.0":      roleKey,
+		},
+	)
+}
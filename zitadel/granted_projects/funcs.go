@@ -0,0 +1,67 @@
+package granted_projects
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/project"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var queries []*project.ProjectQuery
+	if name := d.Get(NameVar).(string); name != "" {
+		queries = append(queries, &project.ProjectQuery{
+			Query: &project.ProjectQuery_NameQuery{
+				NameQuery: &project.ProjectNameQuery{
+					Name:   name,
+					Method: object.TextQueryMethod(object.TextQueryMethod_value[d.Get(nameMethodVar).(string)]),
+				},
+			},
+		})
+	}
+	grantedProjects := make([]interface{}, 0)
+	for offset := uint64(0); ; offset += listPageSize {
+		resp, err := client.ListGrantedProjects(helper.CtxWithOrgID(ctx, d), &management.ListGrantedProjectsRequest{
+			Query:   &object.ListQuery{Offset: offset, Limit: listPageSize},
+			Queries: queries,
+		})
+		if err != nil {
+			return diag.Errorf("failed to list granted projects: %v", err)
+		}
+		for _, grantedProject := range resp.GetResult() {
+			grantedProjects = append(grantedProjects, map[string]interface{}{
+				projectIDVar:        grantedProject.GetProjectId(),
+				ProjectGrantIDVar:   grantedProject.GetGrantId(),
+				projectNameVar:      grantedProject.GetProjectName(),
+				projectOwnerIDVar:   grantedProject.GetProjectOwnerId(),
+				projectOwnerNameVar: grantedProject.GetProjectOwnerName(),
+				roleKeysVar:         grantedProject.GetGrantedRoleKeys(),
+				stateVar:            grantedProject.GetState().String(),
+			})
+		}
+		if len(resp.GetResult()) < listPageSize {
+			break
+		}
+	}
+	// If the ID is blank, the datasource is deleted and not usable.
+	d.SetId("-")
+	return diag.FromErr(d.Set(GrantedProjectsVar, grantedProjects))
+}
@@ -0,0 +1,83 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/app"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+)
+
+const appListPageSize = 1000
+
+type projectApp struct {
+	projectID string
+	app       *app.App
+}
+
+var orgAppsLock = &sync.Mutex{}
+var orgApps = make(map[string][]projectApp)
+
+// FindApp returns the first app of the projects owned by the organization which matches.
+// The management API has no queries for client IDs or entity IDs, so listing the apps needs a request per project and page of apps.
+// The listing is cached per organization for the run of the provider and only listed again if no cached app matches,
+// for example because the app was created in the same run. Apps of granted projects are not found.
+func FindApp(ctx context.Context, client *mgmt.Client, orgID string, match func(*app.App) bool) (projectID string, found *app.App, err error) {
+	orgAppsLock.Lock()
+	defer orgAppsLock.Unlock()
+	if projectID, found := matchApp(orgApps[orgID], match); found != nil {
+		return projectID, found, nil
+	}
+	apps, err := listOrgApps(CtxSetOrgID(ctx, orgID), client)
+	if err != nil {
+		return "", nil, err
+	}
+	orgApps[orgID] = apps
+	projectID, found = matchApp(apps, match)
+	return projectID, found, nil
+}
+
+func matchApp(apps []projectApp, match func(*app.App) bool) (string, *app.App) {
+	for _, a := range apps {
+		if match(a.app) {
+			return a.projectID, a.app
+		}
+	}
+	return "", nil
+}
+
+// listOrgApps pages through the apps of all projects of the organization in the context
+func listOrgApps(ctx context.Context, client *mgmt.Client) ([]projectApp, error) {
+	var result []projectApp
+	for projectOffset := uint64(0); ; projectOffset += appListPageSize {
+		projects, err := client.ListProjects(ctx, &management.ListProjectsRequest{
+			Query: &object.ListQuery{Offset: projectOffset, Limit: appListPageSize},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+		for _, project := range projects.GetResult() {
+			for appOffset := uint64(0); ; appOffset += appListPageSize {
+				apps, err := client.ListApps(ctx, &management.ListAppsRequest{
+					ProjectId: project.GetId(),
+					Query:     &object.ListQuery{Offset: appOffset, Limit: appListPageSize},
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to list apps of project %s: %w", project.GetId(), err)
+				}
+				for _, a := range apps.GetResult() {
+					result = append(result, projectApp{projectID: project.GetId(), app: a})
+				}
+				if len(apps.GetResult()) < appListPageSize {
+					break
+				}
+			}
+		}
+		if len(projects.GetResult()) < appListPageSize {
+			return result, nil
+		}
+	}
+}
@@ -8,41 +8,56 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oidcclient "github.com/zitadel/oidc/v3/pkg/client"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/admin"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/middleware"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/system"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/auth"
+	userv2 "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user/v2"
+	"golang.org/x/oauth2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 const (
-	DomainVar                 = "domain"
-	DomainDescription         = "Domain used to connect to the ZITADEL instance"
-	InsecureVar               = "insecure"
-	InsecureDescription       = "Use insecure connection"
-	TokenVar                  = "token"
-	TokenDescription          = "Path to the file containing credentials to connect to ZITADEL"
-	PortVar                   = "port"
-	PortDescription           = "Used port if not the default ports 80 or 443 are configured"
-	JWTFileVar                = "jwt_file"
-	JWTFileDescription        = "Path to the file containing presigned JWT to connect to ZITADEL. Either 'jwt_file', 'jwt_profile_file' or 'jwt_profile_json' is required"
-	JWTProfileFileVar         = "jwt_profile_file"
-	JWTProfileFileDescription = "Path to the file containing credentials to connect to ZITADEL. Either 'jwt_file', 'jwt_profile_file' or 'jwt_profile_json' is required"
-	JWTProfileJSONVar         = "jwt_profile_json"
-	JWTProfileJSONDescription = "JSON value of credentials to connect to ZITADEL. Either 'jwt_file', 'jwt_profile_file' or 'jwt_profile_json' is required"
+	DomainVar                   = "domain"
+	DomainDescription           = "Domain used to connect to the ZITADEL instance"
+	InsecureVar                 = "insecure"
+	InsecureDescription         = "Use insecure connection"
+	TokenVar                    = "token"
+	TokenDescription            = "Path to the file containing credentials to connect to ZITADEL"
+	PortVar                     = "port"
+	PortDescription             = "Used port if not the default ports 80 or 443 are configured"
+	JWTFileVar                  = "jwt_file"
+	JWTFileDescription          = "Path to the file containing presigned JWT to connect to ZITADEL. Either 'jwt_file', 'jwt_profile_file' or 'jwt_profile_json' is required"
+	JWTProfileFileVar           = "jwt_profile_file"
+	JWTProfileFileDescription   = "Path to the file containing credentials to connect to ZITADEL. Either 'jwt_file', 'jwt_profile_file' or 'jwt_profile_json' is required"
+	JWTProfileJSONVar           = "jwt_profile_json"
+	JWTProfileJSONDescription   = "JSON value of credentials to connect to ZITADEL. Either 'jwt_file', 'jwt_profile_file' or 'jwt_profile_json' is required"
+	SystemAPIUserVar            = "system_api_user"
+	SystemAPIUserDescription    = "ID of the system API user, required to manage instances through the system API. Also requires either 'system_api_key' or 'system_api_key_file'"
+	SystemAPIKeyVar             = "system_api_key"
+	SystemAPIKeyDescription     = "PEM encoded private key of the system API user"
+	SystemAPIKeyFileVar         = "system_api_key_file"
+	SystemAPIKeyFileDescription = "Path to the file containing the PEM encoded private key of the system API user"
+
+	systemAPITokenLifetime = time.Hour
+	systemAPITokenRenewal  = time.Minute
 )
 
 type ClientInfo struct {
-	Domain  string
-	Issuer  string
-	KeyPath string
-	Data    []byte
-	Options []zitadel.Option
+	Domain        string
+	Issuer        string
+	KeyPath       string
+	Data          []byte
+	Options       []zitadel.Option
+	SystemOptions []zitadel.Option
 }
 
-func GetClientInfo(ctx context.Context, insecure bool, domain string, token string, jwtFile string, jwtProfileFile string, jwtProfileJSON string, port string) (*ClientInfo, error) {
+func GetClientInfo(ctx context.Context, insecure bool, domain string, token string, jwtFile string, jwtProfileFile string, jwtProfileJSON string, port string, systemAPIUser string, systemAPIKeyFile string, systemAPIKey string) (*ClientInfo, error) {
 	options := make([]zitadel.Option, 0)
 	keyPath := ""
 	if token != "" {
@@ -59,7 +74,7 @@ func GetClientInfo(ctx context.Context, insecure bool, domain string, token stri
 		keyPath = jwtProfileFile
 	} else if jwtProfileJSON != "" {
 		options = append(options, zitadel.WithJWTProfileTokenSource(middleware.JWTProfileFromFileData(context.Background(), []byte(jwtProfileJSON))))
-	} else {
+	} else if systemAPIUser == "" {
 		return nil, fmt.Errorf("either 'jwt_file', 'jwt_profile_file' or 'jwt_profile_json' is required")
 	}
 
@@ -87,15 +102,67 @@ func GetClientInfo(ctx context.Context, insecure bool, domain string, token stri
 		}
 	}
 
+	var systemOptions []zitadel.Option
+	if systemAPIUser != "" {
+		systemAPITokenSource, err := getSystemAPITokenSource(issuer, systemAPIUser, systemAPIKeyFile, systemAPIKey)
+		if err != nil {
+			return nil, err
+		}
+		systemOptions = append(systemOptions, zitadel.WithJWTProfileTokenSource(func(string, []string) (oauth2.TokenSource, error) {
+			return systemAPITokenSource, nil
+		}))
+		if insecure {
+			systemOptions = append(systemOptions, zitadel.WithInsecure())
+		}
+	}
+
 	return &ClientInfo{
 		clientDomain,
 		issuer,
 		keyPath,
 		[]byte(jwtProfileJSON),
 		options,
+		systemOptions,
 	}, nil
 }
 
+// getSystemAPITokenSource returns a token source for the system API user.
+// The system API expects a self-signed JWT as bearer token, so a new JWT is signed whenever the previous one is about to expire.
+func getSystemAPITokenSource(issuer, systemAPIUser, systemAPIKeyFile, systemAPIKey string) (oauth2.TokenSource, error) {
+	key := []byte(systemAPIKey)
+	if systemAPIKeyFile != "" {
+		var err error
+		key, err = os.ReadFile(systemAPIKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read system API key file: %v", err)
+		}
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("either 'system_api_key' or 'system_api_key_file' is required if 'system_api_user' is set")
+	}
+	signer, err := oidcclient.NewSignerFromPrivateKeyByte(key, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse system API key: %v", err)
+	}
+	return oauth2.ReuseTokenSource(nil, tokenSourceFunc(func() (*oauth2.Token, error) {
+		issuedAt := time.Now()
+		token, err := oidcclient.SignedJWTProfileAssertion(systemAPIUser, []string{issuer}, systemAPITokenLifetime, signer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign system API token: %v", err)
+		}
+		return &oauth2.Token{
+			AccessToken: token,
+			TokenType:   oidc.BearerToken,
+			// renew the token a bit earlier to tolerate clock skew between the provider and ZITADEL
+			Expiry: issuedAt.Add(systemAPITokenLifetime - systemAPITokenRenewal),
+		}, nil
+	})), nil
+}
+
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) { return f() }
+
 var adminClientLock = &sync.Mutex{}
 var adminClient *admin.Client
 
@@ -142,6 +209,79 @@ func GetManagementClient(ctx context.Context, info *ClientInfo) (*management.Cli
 	return mgmtClient, nil
 }
 
+var userV2ClientLock = &sync.Mutex{}
+var userV2Client userv2.UserServiceClient
+
+// GetUserV2Client returns a client for the user service of the v2 API, which provides calls that are not available in the management API
+func GetUserV2Client(ctx context.Context, info *ClientInfo) (userv2.UserServiceClient, error) {
+	if userV2Client == nil {
+		userV2ClientLock.Lock()
+		defer userV2ClientLock.Unlock()
+		if userV2Client == nil {
+			conn, err := zitadel.NewConnection(ctx,
+				info.Issuer, info.Domain,
+				[]string{oidc.ScopeOpenID, zitadel.ScopeZitadelAPI()},
+				info.Options...,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to start zitadel client: %v", err)
+			}
+			time.Sleep(time.Second * 2)
+			userV2Client = userv2.NewUserServiceClient(conn.ClientConn)
+		}
+	}
+	return userV2Client, nil
+}
+
+var authClientLock = &sync.Mutex{}
+var authClient auth.AuthServiceClient
+
+// GetAuthClient returns a client for the auth API, which provides calls about the user the provider is authenticated with
+func GetAuthClient(ctx context.Context, info *ClientInfo) (auth.AuthServiceClient, error) {
+	if authClient == nil {
+		authClientLock.Lock()
+		defer authClientLock.Unlock()
+		if authClient == nil {
+			conn, err := zitadel.NewConnection(ctx,
+				info.Issuer, info.Domain,
+				[]string{oidc.ScopeOpenID, zitadel.ScopeZitadelAPI()},
+				info.Options...,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to start zitadel client: %v", err)
+			}
+			time.Sleep(time.Second * 2)
+			authClient = auth.NewAuthServiceClient(conn.ClientConn)
+		}
+	}
+	return authClient, nil
+}
+
+var systemClientLock = &sync.Mutex{}
+var systemClient *system.Client
+
+func GetSystemClient(ctx context.Context, info *ClientInfo) (*system.Client, error) {
+	if info.SystemOptions == nil {
+		return nil, fmt.Errorf("the system API is not configured, '%s' and either '%s' or '%s' are required in the provider configuration", SystemAPIUserVar, SystemAPIKeyVar, SystemAPIKeyFileVar)
+	}
+	if systemClient == nil {
+		systemClientLock.Lock()
+		defer systemClientLock.Unlock()
+		if systemClient == nil {
+			client, err := system.NewClient(ctx,
+				info.Issuer, info.Domain,
+				[]string{oidc.ScopeOpenID},
+				info.SystemOptions...,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to start zitadel client: %v", err)
+			}
+			systemClient = client
+		}
+	}
+	return systemClient, nil
+}
+
 func CtxWithID(ctx context.Context, d *schema.ResourceData) context.Context {
 	return CtxSetOrgID(ctx, GetID(d, OrgIDVar))
 }
@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GetFromIssuer fetches the public document at the path of the issuer, for example the OIDC discovery document
+func GetFromIssuer(ctx context.Context, clientinfo *ClientInfo, path string) ([]byte, error) {
+	return GetURL(ctx, clientinfo.Issuer+path)
+}
+
+// GetURL fetches the public document at the URL and fails if the response status is not OK
+func GetURL(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response from %s: %v", url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s: unexpected status %s", url, resp.Status)
+	}
+	return body, nil
+}
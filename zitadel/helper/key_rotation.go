@@ -0,0 +1,147 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	ExpirationDateVar = "expiration_date"
+	LifetimeVar       = "lifetime"
+	RotateBeforeVar   = "rotate_before"
+	ExpiresInVar      = "expires_in"
+)
+
+var (
+	LifetimeResourceField = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Duration after which the credential expires, counted from its creation, for example 8760h. In contrast to a fixed expiration_date, a replacement gets a new expiration date, which makes rotate_before useful.",
+		ConflictsWith:    []string{ExpirationDateVar},
+		ValidateDiagFunc: durationValidation(LifetimeVar),
+	}
+	RotateBeforeResourceField = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Duration before the expiration date in which the credential is due for rotation, for example 720h. Inside the window, a warning is shown and the credential is planned for replacement, unless it was already created inside the window. A replacement keeps a fixed expiration_date, so move the expiration_date to extend the validity. Use the lifecycle meta-argument create_before_destroy to create the new credential before the old one is removed.",
+		ValidateDiagFunc: durationValidation(RotateBeforeVar),
+	}
+	ExpiresInResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Duration until the credential expires at the time of the last refresh, negative if it is already expired",
+	}
+)
+
+// CreationDateFunc returns the creation date of the credential with the ID of the resource
+type CreationDateFunc func(ctx context.Context, d *schema.ResourceDiff, clientinfo *ClientInfo) (time.Time, error)
+
+func durationValidation(attribute string) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		if _, err := time.ParseDuration(i.(string)); err != nil {
+			return diag.Errorf("%s must be a duration like 720h: %v", attribute, err)
+		}
+		return nil
+	}
+}
+
+// GetExpirationDate returns the configured expiration date or the end of the configured lifetime.
+// It returns nil if none of them is configured.
+func GetExpirationDate(d *schema.ResourceData) (*timestamppb.Timestamp, error) {
+	if expiration, ok := d.GetOk(ExpirationDateVar); ok {
+		t, err := time.Parse(time.RFC3339, expiration.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse time: %v", err)
+		}
+		return timestamppb.New(t), nil
+	}
+	if lifetime, ok := d.GetOk(LifetimeVar); ok {
+		duration, err := time.ParseDuration(lifetime.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", LifetimeVar, err)
+		}
+		return timestamppb.New(time.Now().Add(duration)), nil
+	}
+	return nil, nil
+}
+
+// rotationWindow returns the start of the rotate before window and true if the expiration date is inside it
+func rotationWindow(expirationDate, rotateBefore string) (time.Time, bool) {
+	if expirationDate == "" || rotateBefore == "" {
+		return time.Time{}, false
+	}
+	expiration, err := time.Parse(time.RFC3339, expirationDate)
+	if err != nil {
+		return time.Time{}, false
+	}
+	before, err := time.ParseDuration(rotateBefore)
+	if err != nil {
+		return time.Time{}, false
+	}
+	start := expiration.Add(-before)
+	return start, !time.Now().Before(start)
+}
+
+// ExpiresIn returns the expires_in value for the expiration date
+func ExpiresIn(expiration time.Time) string {
+	return time.Until(expiration).Truncate(time.Minute).String()
+}
+
+// ExpiryWarning returns a warning if the credential is inside the rotate before window
+func ExpiryWarning(d *schema.ResourceData, credential string, expiration time.Time) diag.Diagnostics {
+	if _, ok := rotationWindow(expiration.Format(time.RFC3339), d.Get(RotateBeforeVar).(string)); !ok {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s %s expires at %s", credential, d.Id(), expiration.Format(time.RFC3339)),
+		Detail:   fmt.Sprintf("The expiration date is inside the %s window of %s. The %s is replaced with the next apply, unless it was already created inside the window.", RotateBeforeVar, d.Get(RotateBeforeVar), credential),
+	}}
+}
+
+// CustomizeDiffRotateBefore plans the replacement of a credential inside the rotate before window.
+// A credential which was created inside the window is not replaced again,
+// so a replacement with a fixed expiration date doesn't cause a replacement on every apply.
+func CustomizeDiffRotateBefore(ctx context.Context, d *schema.ResourceDiff, m interface{}, creationDate CreationDateFunc, secretVar string) error {
+	if d.Id() == "" || d.HasChange(ExpirationDateVar) {
+		return nil
+	}
+	start, ok := rotationWindow(d.Get(ExpirationDateVar).(string), d.Get(RotateBeforeVar).(string))
+	if !ok {
+		return nil
+	}
+	clientinfo, ok := m.(*ClientInfo)
+	if !ok {
+		return fmt.Errorf("failed to get client")
+	}
+	created, err := creationDate(ctx, d, clientinfo)
+	if err != nil {
+		return err
+	}
+	if !created.Before(start) {
+		return nil
+	}
+	// a credential imported without its secret has no secret which could change, so the expiration date forces the replacement
+	forceNewVar := secretVar
+	if d.Get(secretVar).(string) == "" {
+		forceNewVar = ExpirationDateVar
+	}
+	// with a lifetime, the replacement gets a new expiration date
+	if _, ok := d.GetOk(LifetimeVar); ok || forceNewVar == ExpirationDateVar {
+		if err := d.SetNewComputed(ExpirationDateVar); err != nil {
+			return err
+		}
+	}
+	if forceNewVar == secretVar {
+		if err := d.SetNewComputed(secretVar); err != nil {
+			return err
+		}
+	}
+	return d.ForceNew(forceNewVar)
+}
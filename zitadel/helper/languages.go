@@ -0,0 +1,92 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	frameworkdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+)
+
+// UndefinedLanguage is the language tag ZITADEL uses if no language is set
+const UndefinedLanguage = "und"
+
+// GetAllowedLanguages returns the languages which are allowed by the restrictions of the instance.
+// If no languages are restricted, all supported languages are returned.
+func GetAllowedLanguages(ctx context.Context, info *ClientInfo) ([]string, error) {
+	client, err := GetManagementClient(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetAllowedLanguages(ctx, &management.GetAllowedLanguagesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get allowed languages: %v", err)
+	}
+	return resp.GetLanguages(), nil
+}
+
+// ValidateAllowedLanguage returns an error if the language is not allowed by the restrictions of the instance.
+// Empty and undefined languages are always valid.
+func ValidateAllowedLanguage(ctx context.Context, info *ClientInfo, language string) error {
+	if language == "" || language == UndefinedLanguage {
+		return nil
+	}
+	allowed, err := GetAllowedLanguages(ctx, info)
+	if err != nil {
+		return err
+	}
+	for _, allowedLanguage := range allowed {
+		if allowedLanguage == language {
+			return nil
+		}
+	}
+	return fmt.Errorf(`language "%s" is not allowed by the instance restrictions, allowed languages are: %s`, language, strings.Join(allowed, ", "))
+}
+
+// notAllowedDetail explains why a language which isn't allowed yet is only a warning
+const notAllowedDetail = "The apply fails if the language is still not allowed when the resource is applied. " +
+	"If zitadel_instance_restrictions allows the language in the same apply, make this resource depend on it."
+
+// AllowedLanguageWarning returns a warning if the language is not allowed by the current restrictions of the instance.
+// It is no error, because the restrictions might allow the language in the same apply.
+func AllowedLanguageWarning(ctx context.Context, info *ClientInfo, language string) diag.Diagnostics {
+	if err := ValidateAllowedLanguage(ctx, info, language); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  err.Error(),
+			Detail:   notAllowedDetail,
+		}}
+	}
+	return nil
+}
+
+// ValidatePlannedLanguage validates the language attribute of a framework resource plan against the allowed languages of the instance.
+// The current restrictions might still change in the same apply, so a language which isn't allowed yet is only a warning.
+// The remote call is only made if the resource is created or the language changes.
+func ValidatePlannedLanguage(ctx context.Context, info *ClientInfo, state tfsdk.State, plan tfsdk.Plan, languageVar string) frameworkdiag.Diagnostics {
+	var diags frameworkdiag.Diagnostics
+	if info == nil || plan.Raw.IsNull() {
+		return diags
+	}
+	var planned types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root(languageVar), &planned)...)
+	if diags.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return diags
+	}
+	if !state.Raw.IsNull() {
+		var current types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(languageVar), &current)...)
+		if diags.HasError() || current.Equal(planned) {
+			return diags
+		}
+	}
+	if err := ValidateAllowedLanguage(ctx, info, planned.ValueString()); err != nil {
+		diags.AddAttributeWarning(path.Root(languageVar), "language not allowed by the current instance restrictions", err.Error()+". "+notAllowedDetail)
+	}
+	return diags
+}
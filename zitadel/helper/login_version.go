@@ -0,0 +1,85 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/app"
+)
+
+const (
+	LoginVersionVar = "login_version"
+	LoginBaseURIVar = "login_base_uri"
+
+	LoginVersionUnspecified = "LOGIN_VERSION_UNSPECIFIED"
+	LoginVersion1           = "LOGIN_VERSION_1"
+	LoginVersion2           = "LOGIN_VERSION_2"
+)
+
+var loginVersions = []string{LoginVersionUnspecified, LoginVersion1, LoginVersion2}
+
+var (
+	LoginVersionResourceField = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Login UI version used by the application, supported values: " + strings.Join(loginVersions, ", ") + ". If unspecified, the default of the instance is used.",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(loginVersions, false)),
+		Default:          LoginVersionUnspecified,
+	}
+	LoginBaseURIResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Base URI of the login UI, only used with " + LoginVersion2 + ". If empty, the login UI of the instance is used.",
+	}
+	LoginVersionDatasourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Login UI version used by the application",
+	}
+	LoginBaseURIDatasourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Base URI of the login UI",
+	}
+)
+
+// GetLoginVersion returns the configured login version, nil means the default of the instance is used
+func GetLoginVersion(d *schema.ResourceData) (*app.LoginVersion, error) {
+	baseURI := d.Get(LoginBaseURIVar).(string)
+	switch d.Get(LoginVersionVar).(string) {
+	case LoginVersion1:
+		if baseURI != "" {
+			return nil, fmt.Errorf("%s is only supported with %s", LoginBaseURIVar, LoginVersion2)
+		}
+		return &app.LoginVersion{Version: &app.LoginVersion_LoginV1{LoginV1: &app.LoginV1{}}}, nil
+	case LoginVersion2:
+		v2 := &app.LoginV2{}
+		if baseURI != "" {
+			v2.BaseUri = &baseURI
+		}
+		return &app.LoginVersion{Version: &app.LoginVersion_LoginV2{LoginV2: v2}}, nil
+	default:
+		if baseURI != "" {
+			return nil, fmt.Errorf("%s is only supported with %s", LoginBaseURIVar, LoginVersion2)
+		}
+		return nil, nil
+	}
+}
+
+// LoginVersionState returns the state of the login version attributes
+func LoginVersionState(version *app.LoginVersion) map[string]interface{} {
+	set := map[string]interface{}{
+		LoginVersionVar: LoginVersionUnspecified,
+		LoginBaseURIVar: "",
+	}
+	switch {
+	case version.GetLoginV1() != nil:
+		set[LoginVersionVar] = LoginVersion1
+	case version.GetLoginV2() != nil:
+		set[LoginVersionVar] = LoginVersion2
+		set[LoginBaseURIVar] = version.GetLoginV2().GetBaseUri()
+	}
+	return set
+}
@@ -0,0 +1,113 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MemberRoleScopeInstance     = "instance"
+	MemberRoleScopeOrg          = "org"
+	MemberRoleScopeProject      = "project"
+	MemberRoleScopeProjectGrant = "project_grant"
+)
+
+var MemberRoleScopes = []string{MemberRoleScopeInstance, MemberRoleScopeOrg, MemberRoleScopeProject, MemberRoleScopeProjectGrant}
+
+// memberRoles caches the role catalogs per instance and scope, as they don't change during a run
+var memberRoles sync.Map
+
+// ListMemberRoles returns the roles which can be given to members of the scope
+func ListMemberRoles(ctx context.Context, clientinfo *ClientInfo, scope string) ([]string, error) {
+	cacheKey := clientinfo.Issuer + "/" + scope
+	if roles, ok := memberRoles.Load(cacheKey); ok {
+		return roles.([]string), nil
+	}
+	var roles []string
+	switch scope {
+	case MemberRoleScopeInstance:
+		client, err := GetAdminClient(ctx, clientinfo)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.ListIAMMemberRoles(ctx, &admin.ListIAMMemberRolesRequest{})
+		if err != nil {
+			return nil, err
+		}
+		roles = resp.GetRoles()
+	case MemberRoleScopeOrg, MemberRoleScopeProject, MemberRoleScopeProjectGrant:
+		client, err := GetManagementClient(ctx, clientinfo)
+		if err != nil {
+			return nil, err
+		}
+		switch scope {
+		case MemberRoleScopeOrg:
+			resp, err := client.ListOrgMemberRoles(ctx, &management.ListOrgMemberRolesRequest{})
+			if err != nil {
+				return nil, err
+			}
+			roles = resp.GetResult()
+		case MemberRoleScopeProject:
+			resp, err := client.ListProjectMemberRoles(ctx, &management.ListProjectMemberRolesRequest{})
+			if err != nil {
+				return nil, err
+			}
+			roles = resp.GetResult()
+		default:
+			resp, err := client.ListProjectGrantMemberRoles(ctx, &management.ListProjectGrantMemberRolesRequest{})
+			if err != nil {
+				return nil, err
+			}
+			roles = resp.GetResult()
+		}
+	default:
+		return nil, fmt.Errorf("unknown member role scope %s, supported values: %s", scope, strings.Join(MemberRoleScopes, ", "))
+	}
+	memberRoles.Store(cacheKey, roles)
+	return roles, nil
+}
+
+// ValidateMemberRoles checks in the CustomizeDiff of members, that all known roles are part of the role catalog of the scope.
+// If the catalog can't be listed, the validation is skipped and the API validates the roles on apply.
+func ValidateMemberRoles(ctx context.Context, d *schema.ResourceDiff, m interface{}, scope, rolesVar string) error {
+	if !d.HasChange(rolesVar) {
+		return nil
+	}
+	roles := KnownConfigStrings(d, rolesVar)
+	if len(roles) == 0 {
+		return nil
+	}
+	clientinfo, ok := m.(*ClientInfo)
+	if !ok {
+		return nil
+	}
+	catalog, err := ListMemberRoles(ctx, clientinfo, scope)
+	if status.Code(err) == codes.PermissionDenied {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list member roles: %v", err)
+	}
+	valid := make(map[string]bool, len(catalog))
+	for _, role := range catalog {
+		valid[role] = true
+	}
+	sort.Strings(roles)
+	var errs []error
+	for _, role := range roles {
+		if !valid[role] {
+			errs = append(errs, fmt.Errorf(`attribute %s contains role "%s", which is not a valid %s member role, valid roles are: %s`, rolesVar, role, strings.ReplaceAll(scope, "_", " "), strings.Join(catalog, ", ")))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,175 @@
+package helper
+
+import (
+	"encoding/base64"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/metadata"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+)
+
+const metadataListPageSize = 1000
+
+// ListAllMetadata pages through all metadata entries returned by list and maps the keys to the values
+func ListAllMetadata(list func(query *object.ListQuery) ([]*metadata.Metadata, error)) (map[string][]byte, error) {
+	entries := make(map[string][]byte)
+	for offset := uint64(0); ; offset += metadataListPageSize {
+		result, err := list(&object.ListQuery{Offset: offset, Limit: metadataListPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range result {
+			entries[entry.GetKey()] = entry.GetValue()
+		}
+		if len(result) < metadataListPageSize {
+			return entries, nil
+		}
+	}
+}
+
+// MetadataChanges returns the entries which have to be set and the keys which have to be removed,
+// so the remote entries match the desired entries.
+// If exclusive is true, remote keys which are not desired are removed, too.
+func MetadataChanges(desired map[string]string, remote map[string][]byte, previous map[string]string, exclusive bool) (map[string]string, []string) {
+	set := make(map[string]string)
+	for key, value := range desired {
+		if remoteValue, ok := remote[key]; !ok || string(remoteValue) != value {
+			set[key] = value
+		}
+	}
+	var remove []string
+	for key := range remote {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		// keys which are not managed by Terraform are only removed in exclusive mode
+		if _, managed := previous[key]; managed || exclusive {
+			remove = append(remove, key)
+		}
+	}
+	sort.Strings(remove)
+	return set, remove
+}
+
+// SortedMetadataKeys returns the keys of the entries in a deterministic order
+func SortedMetadataKeys(entries map[string]string) []string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// TrackedMetadata returns the remote entries which are tracked in the state.
+// All remote entries are tracked if exclusive is true, for example when importing a resource.
+func TrackedMetadata(remote map[string][]byte, previous map[string]string, exclusive bool) map[string]interface{} {
+	tracked := make(map[string]interface{})
+	for key, value := range remote {
+		if _, managed := previous[key]; managed || exclusive {
+			tracked[key] = string(value)
+		}
+	}
+	return tracked
+}
+
+// MetadataMap converts a schema.TypeMap value to a map of strings
+func MetadataMap(raw interface{}) map[string]string {
+	entries := make(map[string]string)
+	if raw == nil {
+		return entries
+	}
+	for key, value := range raw.(map[string]interface{}) {
+		entries[key] = value.(string)
+	}
+	return entries
+}
+
+const (
+	MetadataValueVar       = "value"
+	MetadataValueBase64Var = "value_base64"
+	MetadataValueJSONVar   = "value_json"
+)
+
+var metadataValueVars = []string{MetadataValueVar, MetadataValueBase64Var, MetadataValueJSONVar}
+
+var (
+	MetadataValueResourceField = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The string representation of a metadata entry value. For binary data, use value_base64.",
+		ExactlyOneOf: metadataValueVars,
+	}
+	MetadataValueBase64ResourceField = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The base64 encoded binary value of a metadata entry. The decoded bytes are stored in ZITADEL.",
+		ExactlyOneOf:     metadataValueVars,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsBase64),
+	}
+	MetadataValueJSONResourceField = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "A JSON document as metadata entry value. Semantically equal documents, for example with reordered keys, don't produce a diff.",
+		ExactlyOneOf:     metadataValueVars,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+		StateFunc: func(i interface{}) string {
+			normalized, _ := structure.NormalizeJsonString(i)
+			return normalized
+		},
+	}
+	MetadataValueDatasourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The string representation of the metadata entry value",
+	}
+	MetadataValueBase64DatasourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The base64 encoded metadata entry value",
+	}
+	MetadataValueJSONDatasourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The normalized JSON document of the metadata entry value, empty if the value is not valid JSON",
+	}
+)
+
+// GetMetadataValue returns the bytes of the configured value representation
+func GetMetadataValue(d *schema.ResourceData) ([]byte, error) {
+	if value, ok := d.GetOk(MetadataValueBase64Var); ok {
+		return base64.StdEncoding.DecodeString(value.(string))
+	}
+	if value, ok := d.GetOk(MetadataValueJSONVar); ok {
+		normalized, err := structure.NormalizeJsonString(value)
+		return []byte(normalized), err
+	}
+	return []byte(d.Get(MetadataValueVar).(string)), nil
+}
+
+// MetadataValueState returns the remote value in the representation which is configured, so it is comparable to the config.
+// If all is true, for example for data sources, the value is returned in all representations.
+func MetadataValueState(d *schema.ResourceData, value []byte, all bool) map[string]interface{} {
+	set := make(map[string]interface{})
+	_, isBase64 := d.GetOk(MetadataValueBase64Var)
+	_, isJSON := d.GetOk(MetadataValueJSONVar)
+	if all || isBase64 {
+		set[MetadataValueBase64Var] = base64.StdEncoding.EncodeToString(value)
+	}
+	if all || isJSON {
+		// invalid JSON is kept as is in resources, so it shows up as drift
+		normalized, err := structure.NormalizeJsonString(string(value))
+		if err != nil && all {
+			normalized = ""
+		}
+		set[MetadataValueJSONVar] = normalized
+	}
+	if all || !isBase64 && !isJSON {
+		set[MetadataValueVar] = string(value)
+	}
+	return set
+}
@@ -0,0 +1,150 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/app"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/auth"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/change"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+)
+
+const (
+	OnDestroyVar        = "on_destroy"
+	onDestroyDelete     = "delete"
+	onDestroyDeactivate = "deactivate"
+
+	deactivatedEventSuffix = ".deactivated"
+)
+
+var OnDestroyResourceField = &schema.Schema{
+	Type:     schema.TypeString,
+	Optional: true,
+	Description: fmt.Sprintf("What happens to the object when the resource is destroyed, supported values: %s, %s. If not set, the object is removed. "+
+		"With %s, the object is deactivated instead of removed and a later apply of the same resource reactivates and adopts the deactivated object again. "+
+		"Only objects whose latest change is their deactivation by the user the provider is authenticated with are adopted, so an unrelated deactivated object with the same name is left alone. "+
+		"The configuration is applied to the adopted object in the same apply", onDestroyDelete, onDestroyDeactivate, onDestroyDeactivate),
+	ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+		switch value.(string) {
+		case onDestroyDelete, onDestroyDeactivate:
+			return nil
+		}
+		return diag.Errorf("%s can only be set to %s or %s", OnDestroyVar, onDestroyDelete, onDestroyDeactivate)
+	},
+}
+
+// DeactivateOnDestroy returns true if the object should be deactivated instead of removed on destroy.
+// Resources which deactivate on destroy also adopt matching deactivated objects on create.
+func DeactivateOnDestroy(d *schema.ResourceData) bool {
+	return d.Get(OnDestroyVar).(string) == onDestroyDeactivate
+}
+
+// IgnoreUnchangedOnAdopt ignores the precondition error the API returns for an update without changes,
+// as create applies the whole configuration to an adopted object, which might already match parts of it
+func IgnoreUnchangedOnAdopt(d *schema.ResourceData, err error) error {
+	if d.IsNewResource() {
+		return IgnorePreconditionError(err)
+	}
+	return err
+}
+
+var providerUserIDLock = &sync.Mutex{}
+var providerUserID string
+
+// getProviderUserID returns the ID of the user the provider is authenticated with
+func getProviderUserID(ctx context.Context, info *ClientInfo) (string, error) {
+	providerUserIDLock.Lock()
+	defer providerUserIDLock.Unlock()
+	if providerUserID == "" {
+		client, err := GetAuthClient(ctx, info)
+		if err != nil {
+			return "", err
+		}
+		resp, err := client.GetMyUser(ctx, &auth.GetMyUserRequest{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get the user of the provider: %v", err)
+		}
+		providerUserID = resp.GetUser().GetId()
+	}
+	return providerUserID, nil
+}
+
+// AdoptDeactivated returns true if the latest change of an object, as returned by listChanges, is its deactivation by the user the provider is authenticated with.
+// In that case, the object is reactivated, so it is adopted only once.
+func AdoptDeactivated(ctx context.Context, info *ClientInfo, listChanges func(query *change.ChangeQuery) ([]*change.Change, error), reactivate func() error) (bool, error) {
+	changes, err := listChanges(&change.ChangeQuery{Limit: 1})
+	if err != nil {
+		return false, fmt.Errorf("failed to list changes: %v", err)
+	}
+	if len(changes) == 0 || !strings.HasSuffix(changes[0].GetEventType().GetKey(), deactivatedEventSuffix) {
+		return false, nil
+	}
+	userID, err := getProviderUserID(ctx, info)
+	if err != nil {
+		return false, err
+	}
+	if changes[0].GetEditorId() != userID {
+		return false, nil
+	}
+	return true, reactivate()
+}
+
+// DeactivateOrRemoveApp deactivates the application if the resource is configured to deactivate on destroy, otherwise it removes the application
+func DeactivateOrRemoveApp(ctx context.Context, client *mgmt.Client, d *schema.ResourceData, projectID string) (err error) {
+	if DeactivateOnDestroy(d) {
+		_, err = client.DeactivateApp(ctx, &management.DeactivateAppRequest{ProjectId: projectID, AppId: d.Id()})
+		// An application which is already inactive can't be deactivated again
+		return IgnorePreconditionError(err)
+	}
+	_, err = client.RemoveApp(ctx, &management.RemoveAppRequest{ProjectId: projectID, AppId: d.Id()})
+	return err
+}
+
+// ReactivateDeactivatedApp reactivates a deactivated application of the project with the given name and matching type,
+// which was deactivated on destroy by the provider.
+// It returns the ID of the reactivated application or an empty string if no such application exists.
+func ReactivateDeactivatedApp(ctx context.Context, info *ClientInfo, client *mgmt.Client, projectID, name string, isType func(*app.App) bool) (string, error) {
+	resp, err := client.ListApps(ctx, &management.ListAppsRequest{
+		ProjectId: projectID,
+		Queries: []*app.AppQuery{{
+			Query: &app.AppQuery_NameQuery{
+				NameQuery: &app.AppNameQuery{
+					Name:   name,
+					Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to search application %s: %v", name, err)
+	}
+	for _, a := range resp.GetResult() {
+		if a.GetState() != app.AppState_APP_STATE_INACTIVE || !isType(a) {
+			continue
+		}
+		adopted, err := AdoptDeactivated(ctx, info, func(query *change.ChangeQuery) ([]*change.Change, error) {
+			resp, err := client.ListAppChanges(ctx, &management.ListAppChangesRequest{Query: query, ProjectId: projectID, AppId: a.GetId()})
+			return resp.GetResult(), err
+		}, func() error {
+			if _, err := client.ReactivateApp(ctx, &management.ReactivateAppRequest{ProjectId: projectID, AppId: a.GetId()}); err != nil {
+				return fmt.Errorf("failed to reactivate application %s: %v", name, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+		if adopted {
+			return a.GetId(), nil
+		}
+	}
+	return "", nil
+}
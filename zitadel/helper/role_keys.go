@@ -0,0 +1,160 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/project"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const roleListPageSize = 1000
+
+// pendingRoleProjects remembers the projects whose roles are added or changed by role resources in the current run.
+// Terraform only plans a grant after the role resources it references, so the roles of these projects can't be validated reliably
+// and the API validates the role keys of their grants on apply, instead.
+var pendingRoleProjects = struct {
+	sync.Mutex
+	projects map[string]bool
+}{projects: make(map[string]bool)}
+
+func pendingRoleProjectsID(clientinfo *ClientInfo, projectID string) string {
+	return clientinfo.Issuer + "/" + projectID
+}
+
+// PlanRoleChanges registers that roles of the project are added or changed, it is called from the CustomizeDiff of project role resources
+func PlanRoleChanges(m interface{}, projectID string) {
+	clientinfo, ok := m.(*ClientInfo)
+	if !ok || projectID == "" {
+		return
+	}
+	pendingRoleProjects.Lock()
+	defer pendingRoleProjects.Unlock()
+	pendingRoleProjects.projects[pendingRoleProjectsID(clientinfo, projectID)] = true
+}
+
+func hasPendingRoleChanges(clientinfo *ClientInfo, projectID string) bool {
+	pendingRoleProjects.Lock()
+	defer pendingRoleProjects.Unlock()
+	return pendingRoleProjects.projects[pendingRoleProjectsID(clientinfo, projectID)]
+}
+
+// KnownConfigStrings returns the known values of a string set or list attribute in the configuration,
+// values which are only known after apply are skipped
+func KnownConfigStrings(d *schema.ResourceDiff, attr string) []string {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	values := config.GetAttr(attr)
+	if values.IsNull() || !values.IsKnown() {
+		return nil
+	}
+	known := make([]string, 0, values.LengthInt())
+	for it := values.ElementIterator(); it.Next(); {
+		_, value := it.Element()
+		if value.IsKnown() && !value.IsNull() && value.Type() == cty.String {
+			known = append(known, value.AsString())
+		}
+	}
+	return known
+}
+
+// ValidateRoleKeys checks in the CustomizeDiff of grants, that all known role keys exist in the project or, if a project grant ID is given, in the project grant.
+// The validation is skipped and the API validates the role keys on apply, if roles of the project are added or changed in the same run or if the roles can't be listed.
+// As CustomizeDiff errors can't point to a set element, unknown roles are reported with the attribute name and the role key.
+func ValidateRoleKeys(ctx context.Context, d *schema.ResourceDiff, m interface{}, projectIDVar, projectGrantIDVar, roleKeysVar string) error {
+	changed := []string{projectIDVar, roleKeysVar}
+	if projectGrantIDVar != "" {
+		changed = append(changed, projectGrantIDVar)
+	}
+	if !d.HasChanges(changed...) {
+		return nil
+	}
+	for _, attr := range append(changed, OrgIDVar) {
+		if !d.NewValueKnown(attr) {
+			return nil
+		}
+	}
+	projectID := d.Get(projectIDVar).(string)
+	roleKeys := KnownConfigStrings(d, roleKeysVar)
+	if projectID == "" || len(roleKeys) == 0 {
+		return nil
+	}
+	clientinfo, ok := m.(*ClientInfo)
+	if !ok || hasPendingRoleChanges(clientinfo, projectID) {
+		return nil
+	}
+	client, err := GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return err
+	}
+	projectGrantID := ""
+	if projectGrantIDVar != "" {
+		projectGrantID = d.Get(projectGrantIDVar).(string)
+	}
+	existing, err := listRoleKeys(CtxSetOrgID(ctx, d.Get(OrgIDVar).(string)), client, projectID, projectGrantID)
+	if code := status.Code(err); code == codes.NotFound || code == codes.PermissionDenied {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list roles of project %s: %v", projectID, err)
+	}
+	sort.Strings(roleKeys)
+	var errs []error
+	for _, key := range roleKeys {
+		if existing[key] {
+			continue
+		}
+		if projectGrantID != "" {
+			errs = append(errs, fmt.Errorf(`attribute %s contains role "%s", which is not granted by project grant %s`, roleKeysVar, key, projectGrantID))
+			continue
+		}
+		errs = append(errs, fmt.Errorf(`attribute %s contains role "%s", which doesn't exist in project %s`, roleKeysVar, key, projectID))
+	}
+	return errors.Join(errs...)
+}
+
+// listRoleKeys pages through the roles of the project or, if a project grant ID is given, the roles granted by the project grant
+func listRoleKeys(ctx context.Context, client *mgmt.Client, projectID, projectGrantID string) (map[string]bool, error) {
+	keys := make(map[string]bool)
+	for offset := uint64(0); ; offset += roleListPageSize {
+		query := &object.ListQuery{Offset: offset, Limit: roleListPageSize}
+		var roles []*project.Role
+		if projectGrantID != "" {
+			resp, err := client.ListGrantedProjectRoles(ctx, &management.ListGrantedProjectRolesRequest{
+				ProjectId: projectID,
+				GrantId:   projectGrantID,
+				Query:     query,
+			})
+			if err != nil {
+				return nil, err
+			}
+			roles = resp.GetResult()
+		} else {
+			resp, err := client.ListProjectRoles(ctx, &management.ListProjectRolesRequest{
+				ProjectId: projectID,
+				Query:     query,
+			})
+			if err != nil {
+				return nil, err
+			}
+			roles = resp.GetResult()
+		}
+		for _, role := range roles {
+			keys[role.GetKey()] = true
+		}
+		if len(roles) < roleListPageSize {
+			return keys, nil
+		}
+	}
+}
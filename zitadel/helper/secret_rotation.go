@@ -0,0 +1,78 @@
+package helper
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	SecretRotationTriggersVar = "secret_rotation_triggers"
+	RotateAfterVar            = "rotate_after"
+	SecretGeneratedAtVar      = "secret_generated_at"
+)
+
+var (
+	SecretRotationTriggersResourceField = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Arbitrary map of values that, when changed, regenerates the secret in place. For example, set a version or a date to rotate the secret on demand.",
+	}
+	RotateAfterResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Duration after which the secret is regenerated in place, for example 720h. The secret is rotated with the first apply after the duration elapsed since secret_generated_at.",
+		ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+			if _, err := time.ParseDuration(i.(string)); err != nil {
+				return diag.Errorf("%s must be a duration like 720h: %v", RotateAfterVar, err)
+			}
+			return nil
+		},
+	}
+	SecretGeneratedAtResourceField = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "RFC3339 timestamp of when the secret was generated by Terraform. Empty if the secret was imported.",
+	}
+)
+
+// SecretRotationDue returns true if the secret was generated by Terraform longer than rotateAfter ago.
+// Secrets with an unknown generation time, for example imported ones, are not rotated because of their age.
+func SecretRotationDue(generatedAt, rotateAfter string) bool {
+	if generatedAt == "" || rotateAfter == "" {
+		return false
+	}
+	generated, err := time.Parse(time.RFC3339, generatedAt)
+	if err != nil {
+		return false
+	}
+	after, err := time.ParseDuration(rotateAfter)
+	if err != nil {
+		return false
+	}
+	return time.Now().After(generated.Add(after))
+}
+
+// SecretGeneratedAt returns the current time in the format of the secret_generated_at attribute
+func SecretGeneratedAt() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// CustomizeDiffSecretRotation plans new values for the secret attributes if the rotation triggers changed or if the secret is due for rotation
+func CustomizeDiffSecretRotation(d *schema.ResourceDiff, secretVars ...string) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.HasChange(SecretRotationTriggersVar) && !SecretRotationDue(d.Get(SecretGeneratedAtVar).(string), d.Get(RotateAfterVar).(string)) {
+		return nil
+	}
+	for _, secretVar := range append(secretVars, SecretGeneratedAtVar) {
+		if err := d.SetNewComputed(secretVar); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -33,13 +33,27 @@ type BaseTestFrame struct {
 }
 
 func NewBaseTestFrame(ctx context.Context, resourceType, domain string, jwtProfileJson []byte) (*BaseTestFrame, error) {
+	return newBaseTestFrame(ctx, resourceType, domain, map[string]string{helper.JWTProfileJSONVar: string(jwtProfileJson)})
+}
+
+// newBaseTestFrame configures the provider with the given credential attributes, for example a JWT profile or a system API user and key
+func newBaseTestFrame(ctx context.Context, resourceType, domain string, credentials map[string]string) (*BaseTestFrame, error) {
 	zitadelProvider := zitadel.Provider()
-	diag := zitadelProvider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
-		"domain":           domain,
-		"insecure":         insecure,
-		"port":             port,
-		"jwt_profile_json": string(jwtProfileJson),
-	}))
+	providerConfig := map[string]interface{}{
+		"domain":   domain,
+		"insecure": insecure,
+		"port":     port,
+	}
+	credentialsSnippet := ""
+	for key, value := range credentials {
+		providerConfig[key] = value
+		credentialsSnippet += fmt.Sprintf(`  %s = trimspace(<<KEY
+%s
+KEY
+  )
+`, key, value)
+	}
+	diag := zitadelProvider.Configure(ctx, terraform.NewResourceConfigRaw(providerConfig))
 	if diag.HasError() {
 		return nil, fmt.Errorf("unknown error configuring the test provider: %v", diag)
 	}
@@ -48,11 +62,8 @@ provider "zitadel" {
   domain   			= "%s"
   insecure 			= "%t"
   port     			= "%s" 
-  jwt_profile_json  = <<KEY
-%s
-KEY
-}
-`, domain, insecure, port, string(jwtProfileJson))
+%s}
+`, domain, insecure, port, credentialsSnippet)
 	clientInfo := zitadelProvider.Meta().(*helper.ClientInfo)
 	uniqueID := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	terraformName := fmt.Sprintf("%s.default", resourceType)
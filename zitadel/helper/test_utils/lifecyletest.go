@@ -81,3 +81,25 @@ func RunLifecyleTest[P comparable](
 		ProtoV6ProviderFactories: frame.v6ProviderFactories,
 	})
 }
+
+// RunStepsTest runs the steps against the test provider.
+// The configuration of each step only contains the resources under test, the provider and the datasources are prepended.
+func RunStepsTest(
+	t *testing.T,
+	frame BaseTestFrame,
+	datasources []string,
+	checkDestroy resource.TestCheckFunc,
+	steps ...resource.TestStep,
+) {
+	for i := range steps {
+		steps[i].Config = fmt.Sprintf("%s\n%s\n%s", frame.ProviderSnippet, strings.Join(datasources, "\n"), steps[i].Config)
+	}
+	testCase := resource.TestCase{
+		Steps:                    steps,
+		ProtoV6ProviderFactories: frame.v6ProviderFactories,
+	}
+	if checkDestroy != nil {
+		testCase.CheckDestroy = CheckAMinute(checkDestroy)
+	}
+	resource.ParallelTest(t, testCase)
+}
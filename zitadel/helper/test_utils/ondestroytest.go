@@ -0,0 +1,55 @@
+package test_utils
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// WithOnDestroyDeactivate adds on_destroy = "deactivate" to the resource of the example
+func WithOnDestroyDeactivate(resourceExample string) string {
+	end := strings.LastIndex(resourceExample, "}")
+	return resourceExample[:end] + "  on_destroy = \"deactivate\"\n" + resourceExample[end:]
+}
+
+// RunOnDestroyDeactivateTest configures a resource with on_destroy = "deactivate".
+// It checks that removing the resource deactivates the remote object
+// and that adding the resource again with a changed configuration adopts the same object and applies the change in the same apply.
+func RunOnDestroyDeactivateTest(
+	t *testing.T,
+	frame BaseTestFrame,
+	datasources []string,
+	resourceConfig, changedResourceConfig string,
+	checkRemoteActive func(id string, active bool) error,
+) {
+	var id string
+	RunStepsTest(
+		t,
+		frame,
+		datasources,
+		func(*terraform.State) error { return checkRemoteActive(id, false) },
+		resource.TestStep{ // Check resource is created
+			Config: resourceConfig,
+			Check: func(state *terraform.State) error {
+				id = frame.State(state).ID
+				return CheckAMinute(func(*terraform.State) error { return checkRemoteActive(id, true) })(state)
+			},
+		},
+		resource.TestStep{ // Check removing the resource deactivates the object
+			Config: "",
+			Check:  CheckAMinute(func(*terraform.State) error { return checkRemoteActive(id, false) }),
+		},
+		resource.TestStep{ // Check adding the resource again adopts and reactivates the object, the empty plan after the apply checks the change is applied
+			Config: changedResourceConfig,
+			Check: func(state *terraform.State) error {
+				if adoptedID := frame.State(state).ID; adoptedID != id {
+					return fmt.Errorf("expected the deactivated object %s to be adopted, but got %s", id, adoptedID)
+				}
+				return CheckAMinute(func(*terraform.State) error { return checkRemoteActive(id, true) })(state)
+			},
+		},
+	)
+}
@@ -0,0 +1,67 @@
+package test_utils
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// RunSecretRotationTest configures the resource with secret_rotation_triggers.
+// It checks that an unchanged trigger keeps the secret and that a changed trigger regenerates the secret in place.
+// The checks are run after each apply.
+func RunSecretRotationTest(
+	t *testing.T,
+	frame BaseTestFrame,
+	datasources []string,
+	resourceFunc func(trigger string) string,
+	secretAttribute string,
+	checks ...resource.TestCheckFunc,
+) {
+	config := func(trigger string) string {
+		return fmt.Sprintf("%s\n%s\n%s", frame.ProviderSnippet, strings.Join(datasources, "\n"), resourceFunc(trigger))
+	}
+	var id, secret string
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{ // Check resource is created with a secret
+				Config: config("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(append([]resource.TestCheckFunc{
+					func(state *terraform.State) error {
+						primary := frame.State(state)
+						id, secret = primary.ID, primary.Attributes[secretAttribute]
+						if secret == "" {
+							return fmt.Errorf("expected %s to be set", secretAttribute)
+						}
+						return nil
+					},
+				}, checks...)...),
+			}, { // Check an unchanged trigger has no diff
+				Config:   config("1"),
+				PlanOnly: true,
+			}, { // Check a changed trigger regenerates the secret in place
+				Config: config("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(append([]resource.TestCheckFunc{
+					func(state *terraform.State) error {
+						primary := frame.State(state)
+						if primary.ID != id {
+							return fmt.Errorf("expected the resource %s to be updated in place, but got %s", id, primary.ID)
+						}
+						if rotated := primary.Attributes[secretAttribute]; rotated == "" || rotated == secret {
+							return fmt.Errorf("expected %s to be regenerated", secretAttribute)
+						}
+						return nil
+					},
+				}, checks...)...),
+			},
+		},
+		ProtoV6ProviderFactories: frame.v6ProviderFactories,
+	})
+}
+
+// WithSecretRotationTrigger adds secret_rotation_triggers with the trigger as version to the resource of the example
+func WithSecretRotationTrigger(resourceExample, trigger string) string {
+	return strings.Replace(resourceExample, "{\n", fmt.Sprintf("{\n  secret_rotation_triggers = { version = \"%s\" }\n", trigger), 1)
+}
@@ -0,0 +1,137 @@
+package test_utils
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// SMTPServer is a minimal SMTP stand-in which accepts any credentials and records the recipients of all received emails.
+type SMTPServer struct {
+	// Host is the address ZITADEL uses to reach the server
+	Host       string
+	listener   net.Listener
+	mu         sync.Mutex
+	recipients []string
+}
+
+// NewSMTPServer starts an SMTP stand-in which is stopped when the test finishes.
+// As ZITADEL runs in a container, the server is announced with the host from ZITADEL_TEST_SMTP_HOST, which defaults to host.docker.internal.
+func NewSMTPServer(t *testing.T) *SMTPServer {
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("failed to start smtp server: %v", err)
+	}
+	host := os.Getenv("ZITADEL_TEST_SMTP_HOST")
+	if host == "" {
+		host = "host.docker.internal"
+	}
+	server := &SMTPServer{
+		Host:     fmt.Sprintf("%s:%d", host, listener.Addr().(*net.TCPAddr).Port),
+		listener: listener,
+	}
+	go server.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+	return server
+}
+
+// CheckReceived checks that at least one email was sent to the recipient.
+func (s *SMTPServer) CheckReceived(recipient string) func(*terraform.State) error {
+	return func(*terraform.State) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, r := range s.recipients {
+			if r == recipient {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected an email to %s, but got emails to %v", recipient, s.recipients)
+	}
+}
+
+func (s *SMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *SMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	reply := func(format string, args ...interface{}) bool {
+		return tp.PrintfLine(format, args...) == nil
+	}
+	if !reply("220 localhost ESMTP") {
+		return
+	}
+	var recipients []string
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			reply("250-localhost\r\n250-AUTH LOGIN\r\n250 8BITMIME")
+		case "AUTH":
+			// Ask for username and password and accept whatever is sent
+			if len(strings.Fields(line)) < 3 {
+				if !reply("334 VXNlcm5hbWU6") {
+					return
+				}
+				if _, err := tp.ReadLine(); err != nil {
+					return
+				}
+			}
+			if !reply("334 UGFzc3dvcmQ6") {
+				return
+			}
+			if _, err := tp.ReadLine(); err != nil {
+				return
+			}
+			reply("235 Authentication succeeded")
+		case "MAIL":
+			recipients = nil
+			reply("250 OK")
+		case "RCPT":
+			recipients = append(recipients, parseAddress(line))
+			reply("250 OK")
+		case "DATA":
+			if !reply("354 End data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			if _, err := io.Copy(io.Discard, tp.DotReader()); err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.recipients = append(s.recipients, recipients...)
+			s.mu.Unlock()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func parseAddress(line string) string {
+	start, end := strings.Index(line, "<"), strings.LastIndex(line, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
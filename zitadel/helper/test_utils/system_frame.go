@@ -0,0 +1,38 @@
+package test_utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/system"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/acceptance"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+type SystemTestFrame struct {
+	BaseTestFrame
+	*system.Client
+}
+
+// NewSystemTestFrame configures the provider with the system API user, so instances can be managed.
+// The system API is reachable through the domain of any instance, so the org level instances domain is used.
+func NewSystemTestFrame(t *testing.T, resourceType string) *SystemTestFrame {
+	ctx := context.Background()
+	cfg := acceptance.GetConfig()
+	baseFrame, err := newBaseTestFrame(ctx, resourceType, cfg.OrgLevel.Domain, map[string]string{
+		helper.SystemAPIUserVar: cfg.SystemAPI.User,
+		helper.SystemAPIKeyVar:  string(cfg.SystemAPI.Key),
+	})
+	if err != nil {
+		t.Fatalf("setting up test context failed: %v", err)
+	}
+	systemClient, err := helper.GetSystemClient(baseFrame.Context, baseFrame.ClientInfo)
+	if err != nil {
+		t.Fatalf("setting up test context failed: %v", err)
+	}
+	return &SystemTestFrame{
+		BaseTestFrame: *baseFrame,
+		Client:        systemClient,
+	}
+}
@@ -0,0 +1,155 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/change"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	userStateActive   = UserStateValue(user.UserState_USER_STATE_ACTIVE)
+	userStateInactive = UserStateValue(user.UserState_USER_STATE_INACTIVE)
+	userStateLocked   = UserStateValue(user.UserState_USER_STATE_LOCKED)
+	userStateInitial  = UserStateValue(user.UserState_USER_STATE_INITIAL)
+)
+
+var UserStateResourceField = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Computed:    true,
+	Description: fmt.Sprintf("State of the user, can be set to %s, %s or %s to reactivate, deactivate or lock the user. If not set, the state is only read. A user in the %s state, for example a human user without a password, is treated as %s and can't be deactivated or locked", userStateActive, userStateInactive, userStateLocked, userStateInitial, userStateActive),
+	ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+		switch value.(string) {
+		case userStateActive, userStateInactive, userStateLocked:
+			return nil
+		}
+		return diag.Errorf("state can only be set to %s, %s or %s", userStateActive, userStateInactive, userStateLocked)
+	},
+	// An initial user is active, it just didn't set up its authentication yet
+	DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+		return oldValue == userStateInitial && newValue == userStateActive
+	},
+}
+
+// UserStateValue maps the state of the API to the value of the state attribute, for example USER_STATE_ACTIVE to active
+func UserStateValue(state user.UserState) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "USER_STATE_"))
+}
+
+// CustomizeDiffUserState reports at plan time, that a user in the initial state can't be deactivated or locked
+func CustomizeDiffUserState(stateVar string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		current, desired := d.GetChange(stateVar)
+		if current.(string) == userStateInitial && desired.(string) != userStateActive {
+			return errInitialUserState(desired.(string))
+		}
+		return nil
+	}
+}
+
+func errInitialUserState(desired string) error {
+	return fmt.Errorf("a user in the %s state can't be set to %s, the user has to set up its authentication first, for example a password", userStateInitial, desired)
+}
+
+// ChangeUserState calls the management API so the user transitions from the current to the desired state
+func ChangeUserState(ctx context.Context, client *mgmt.Client, userID, current, desired string) (err error) {
+	if current == desired {
+		return nil
+	}
+	if current == userStateInitial {
+		if desired == userStateActive {
+			return nil
+		}
+		return errInitialUserState(desired)
+	}
+	switch desired {
+	case userStateActive:
+		switch current {
+		case userStateInactive:
+			_, err = client.ReactivateUser(ctx, &management.ReactivateUserRequest{Id: userID})
+		case userStateLocked:
+			_, err = client.UnlockUser(ctx, &management.UnlockUserRequest{Id: userID})
+		}
+	case userStateInactive:
+		_, err = client.DeactivateUser(ctx, &management.DeactivateUserRequest{Id: userID})
+	case userStateLocked:
+		if current == userStateInactive {
+			if _, err = client.ReactivateUser(ctx, &management.ReactivateUserRequest{Id: userID}); err != nil {
+				return fmt.Errorf("failed to reactivate user before locking: %v", err)
+			}
+		}
+		_, err = client.LockUser(ctx, &management.LockUserRequest{Id: userID})
+	}
+	if status.Code(err) == codes.FailedPrecondition && current == userStateActive {
+		// a newly created human user is in the initial state until it sets up its authentication
+		return fmt.Errorf("failed to change user state from %s to %s, users in the %s state, for example human users without a password, can't be deactivated or locked: %v", current, desired, userStateInitial, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to change user state from %s to %s: %v", current, desired, err)
+	}
+	return nil
+}
+
+// ReactivateDeactivatedUser reactivates a deactivated user with the given user name, which was deactivated on destroy by the provider, so a resource can adopt it again.
+// It returns the ID of the reactivated user or an empty string if no such user exists.
+func ReactivateDeactivatedUser(ctx context.Context, info *ClientInfo, client *mgmt.Client, userName string, machine bool) (string, error) {
+	resp, err := client.ListUsers(ctx, &management.ListUsersRequest{
+		Queries: []*user.SearchQuery{{
+			Query: &user.SearchQuery_UserNameQuery{
+				UserNameQuery: &user.UserNameQuery{
+					UserName: userName,
+					Method:   object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to search user %s: %v", userName, err)
+	}
+	for _, u := range resp.GetResult() {
+		if u.GetState() != user.UserState_USER_STATE_INACTIVE || (u.GetMachine() != nil) != machine {
+			continue
+		}
+		adopted, err := AdoptDeactivated(ctx, info, func(query *change.ChangeQuery) ([]*change.Change, error) {
+			resp, err := client.ListUserChanges(ctx, &management.ListUserChangesRequest{Query: query, UserId: u.GetId()})
+			return resp.GetResult(), err
+		}, func() error {
+			if _, err := client.ReactivateUser(ctx, &management.ReactivateUserRequest{Id: u.GetId()}); err != nil {
+				return fmt.Errorf("failed to reactivate user %s: %v", userName, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+		if adopted {
+			return u.GetId(), nil
+		}
+	}
+	return "", nil
+}
+
+// DeactivateOrRemoveUser deactivates the user if the resource is configured to deactivate on destroy, otherwise it removes the user
+func DeactivateOrRemoveUser(ctx context.Context, client *mgmt.Client, d *schema.ResourceData, current string) (err error) {
+	if DeactivateOnDestroy(d) {
+		// A user which is already inactive can't be deactivated again
+		if current == userStateInactive {
+			return nil
+		}
+		_, err = client.DeactivateUser(ctx, &management.DeactivateUserRequest{Id: d.Id()})
+		return err
+	}
+	_, err = client.RemoveUser(ctx, &management.RemoveUserRequest{Id: d.Id()})
+	return err
+}
@@ -0,0 +1,79 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NormalizeXML returns a canonical form of the XML document, so equivalent documents are comparable.
+// The XML declaration, comments and whitespace between elements are removed,
+// text is trimmed and the attributes of each element are sorted.
+// Namespace prefixes are kept as they are.
+func NormalizeXML(document string) (string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(document))
+	buf := new(bytes.Buffer)
+	encoder := xml.NewEncoder(buf)
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			attrs := make([]xml.Attr, len(t.Attr))
+			for i, attr := range t.Attr {
+				attrs[i] = xml.Attr{Name: prefixedXMLName(attr.Name), Value: attr.Value}
+			}
+			sort.Slice(attrs, func(i, j int) bool { return attrs[i].Name.Local < attrs[j].Name.Local })
+			token = xml.StartElement{Name: prefixedXMLName(t.Name), Attr: attrs}
+		case xml.EndElement:
+			token = xml.EndElement{Name: prefixedXMLName(t.Name)}
+		case xml.CharData:
+			text := strings.TrimSpace(string(t))
+			if text == "" {
+				continue
+			}
+			token = xml.CharData(text)
+		case xml.Comment, xml.ProcInst, xml.Directive:
+			continue
+		}
+		if err := encoder.EncodeToken(token); err != nil {
+			return "", err
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// prefixedXMLName keeps the namespace prefix of a raw token as part of the local name,
+// so the encoder doesn't resolve it to a namespace
+func prefixedXMLName(name xml.Name) xml.Name {
+	if name.Space == "" {
+		return name
+	}
+	return xml.Name{Local: name.Space + ":" + name.Local}
+}
+
+// SuppressEquivalentXMLDiff is a schema.SchemaDiffSuppressFunc which suppresses diffs between equivalent XML documents
+func SuppressEquivalentXMLDiff(_, old, new string, _ *schema.ResourceData) bool {
+	normalizedOld, err := NormalizeXML(old)
+	if err != nil {
+		return false
+	}
+	normalizedNew, err := NormalizeXML(new)
+	if err != nil {
+		return false
+	}
+	return normalizedOld == normalizedNew
+}
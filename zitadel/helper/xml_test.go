@@ -0,0 +1,46 @@
+package helper
+
+import "testing"
+
+func TestNormalizeXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     string
+		wantErr  bool
+	}{{
+		name:     "whitespace, declaration and comments are removed",
+		document: "<?xml version=\"1.0\"?>\n<md:A xmlns:md=\"urn:md\">\n  <!-- comment -->\n  <md:B>  text  </md:B>\n</md:A>\n",
+		want:     `<md:A xmlns:md="urn:md"><md:B>text</md:B></md:A>`,
+	}, {
+		name:     "attributes are sorted",
+		document: `<A z="1" a="2" xmlns:md="urn:md" md:b="3"/>`,
+		want:     `<A a="2" md:b="3" xmlns:md="urn:md" z="1"></A>`,
+	}, {
+		name:     "escaped values are kept",
+		document: `<A href="https://example.com?a=1&amp;b=2">a &lt; b</A>`,
+		want:     `<A href="https://example.com?a=1&amp;b=2">a &lt; b</A>`,
+	}, {
+		name:     "invalid documents fail",
+		document: `<A><B></A>`,
+		wantErr:  true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeXML(tt.document)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeXML() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeXML() = %s, want %s", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			again, err := NormalizeXML(got)
+			if err != nil || again != got {
+				t.Errorf("NormalizeXML() is not idempotent, got %s, %v", again, err)
+			}
+		})
+	}
+}
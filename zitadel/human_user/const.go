@@ -2,8 +2,12 @@ package human_user
 
 const (
 	UserIDVar             = "user_id"
+	userIDsVar            = "user_ids"
 	userStateVar          = "state"
 	UserNameVar           = "user_name"
+	userNameMethodVar     = "user_name_method"
+	LoginNameVar          = "login_name"
+	loginNameMethodVar    = "login_name_method"
 	loginNamesVar         = "login_names"
 	preferredLoginNameVar = "preferred_login_name"
 
@@ -11,11 +15,13 @@ const (
 	lastNameVar          = "last_name"
 	nickNameVar          = "nick_name"
 	DisplayNameVar       = "display_name"
+	displayNameMethodVar = "display_name_method"
 	preferredLanguageVar = "preferred_language"
 	genderVar            = "gender"
 
 	isEmailVerifiedVar = "is_email_verified"
-	emailVar           = "email"
+	EmailVar           = "email"
+	emailMethodVar     = "email_method"
 
 	isPhoneVerifiedVar = "is_phone_verified"
 	phoneVar           = "phone"
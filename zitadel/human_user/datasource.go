@@ -1,24 +1,36 @@
 package human_user
 
 import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
 
 func GetDatasource() *schema.Resource {
 	return &schema.Resource{
-		Description: "Datasource representing a human user situated under an organization, which then can be authorized through memberships or direct grants on other resources.",
+		Description: "Datasource representing a human user situated under an organization, which then can be authorized through memberships or direct grants on other resources. The user is looked up by exactly one of user_id, login_name or email.",
 		Schema: map[string]*schema.Schema{
 			UserIDVar: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The ID of this resource.",
+				ExactlyOneOf: []string{UserIDVar, LoginNameVar, EmailVar},
+			},
+			LoginNameVar: {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The ID of this resource.",
+				Optional:    true,
+				Description: "Login name to look up the user by, for example the username followed by the organizations domain. The user is searched on the whole instance.",
 			},
 			helper.OrgIDVar: {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "ID of the organization",
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the organization. If the user is looked up by email, it is searched in this organization",
 			},
 			userStateVar: {
 				Type:        schema.TypeString,
@@ -73,10 +85,11 @@ func GetDatasource() *schema.Resource {
 				Description: "Gender of the user",
 				Computed:    true,
 			},
-			emailVar: {
+			EmailVar: {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "Email of the user",
+				Description: "Email of the user. If set, it is used to look up the user, which must be the only human user with this email in the organization",
 			},
 			isEmailVerifiedVar: {
 				Type:        schema.TypeBool,
@@ -94,6 +107,66 @@ func GetDatasource() *schema.Resource {
 				Description: "Is the phone verified of the user",
 			},
 		},
-		ReadContext: readFunc(true),
+		ReadContext: get,
+	}
+}
+
+func ListDatasources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing a list of human users situated under an organization, filtered by the given attributes.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDDatasourceField,
+			userIDsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A set of all IDs.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			UserNameVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Username",
+			},
+			userNameMethodVar: textQueryMethodField(userNameMethodVar, "username"),
+			EmailVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Email of the user",
+			},
+			emailMethodVar: textQueryMethodField(emailMethodVar, "email"),
+			LoginNameVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Login name of the user",
+			},
+			loginNameMethodVar: textQueryMethodField(loginNameMethodVar, "login name"),
+			DisplayNameVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Display name of the user",
+			},
+			displayNameMethodVar: textQueryMethodField(displayNameMethodVar, "display name"),
+			userStateVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "State of the user" + helper.DescriptionEnumValuesList(user.UserState_name),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return helper.EnumValueValidation(userStateVar, value, user.UserState_value)
+				},
+			},
+		},
+		ReadContext: list,
+	}
+}
+
+func textQueryMethodField(methodVar, attribute string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Method for querying human users by " + attribute + helper.DescriptionEnumValuesList(object.TextQueryMethod_name),
+		ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+			return helper.EnumValueValidation(methodVar, value, object.TextQueryMethod_value)
+		},
+		Default: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS_IGNORE_CASE.String(),
 	}
 }
@@ -0,0 +1,127 @@
+package human_user_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/human_user"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/human_user/human_user_test_dep"
+)
+
+func TestAccHumanUserDatasource_ID(t *testing.T) {
+	datasourceName := "zitadel_human_user"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	exampleID := test_utils.AttributeValue(t, human_user.UserIDVar, attributes).AsString()
+	// the example also shows the lookups by login name and email, so we cut it down to the first block
+	config = strings.Join(strings.Split(config, "\n")[0:4], "\n")
+	_, userID := human_user_test_dep.Create(t, frame)
+	config = strings.Replace(config, exampleID, userID, 1)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		nil,
+		map[string]string{
+			"org_id":    frame.OrgID,
+			"user_id":   userID,
+			"user_name": frame.UniqueResourcesID,
+		},
+	)
+}
+
+func TestAccHumanUserDatasource_LoginName(t *testing.T) {
+	datasourceName := "zitadel_human_user"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	_, userID := human_user_test_dep.Create(t, frame)
+	remoteUser, err := frame.GetUserByID(frame, &management.GetUserByIDRequest{Id: userID})
+	if err != nil {
+		t.Fatalf("failed to get user: %v", err)
+	}
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		fmt.Sprintf(`
+data "zitadel_human_user" "default" {
+  login_name = "%s"
+}`, remoteUser.GetUser().GetPreferredLoginName()),
+		nil,
+		nil,
+		map[string]string{
+			"org_id":    frame.OrgID,
+			"user_id":   userID,
+			"user_name": frame.UniqueResourcesID,
+		},
+	)
+}
+
+func TestAccHumanUserDatasource_Email(t *testing.T) {
+	datasourceName := "zitadel_human_user"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	_, userID := human_user_test_dep.Create(t, frame)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		`
+data "zitadel_human_user" "default" {
+  org_id = data.zitadel_org.default.id
+  email  = "DONT@care.com"
+}`,
+		[]string{frame.AsOrgDefaultDependency},
+		nil,
+		map[string]string{
+			"user_id":   userID,
+			"user_name": frame.UniqueResourcesID,
+		},
+	)
+}
+
+func TestAccHumanUsersDatasources_ID_Email_Match(t *testing.T) {
+	datasourceName := "zitadel_human_users"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, _ := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	// for-each is not supported in acceptance tests, so we cut the example down to the first block
+	// https://github.com/hashicorp/terraform-plugin-sdk/issues/536
+	config = strings.Join(strings.Split(config, "\n")[0:6], "\n")
+	config = strings.Replace(config, "example.com", "care.com", 1)
+	// the dependency user has no password, so it stays in the initial state
+	config = strings.Replace(config, "USER_STATE_ACTIVE", "USER_STATE_INITIAL", 1)
+	_, userID := human_user_test_dep.Create(t, frame)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		nil,
+		map[string]string{
+			"user_ids.0": userID,
+			"user_ids.#": "1",
+		},
+	)
+}
+
+func TestAccHumanUsersDatasources_ID_Email_Mismatch(t *testing.T) {
+	datasourceName := "zitadel_human_users"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, _ := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	// for-each is not supported in acceptance tests, so we cut the example down to the first block
+	// https://github.com/hashicorp/terraform-plugin-sdk/issues/536
+	config = strings.Join(strings.Split(config, "\n")[0:6], "\n")
+	config = strings.Replace(config, "example.com", "mismatch.com", 1)
+	human_user_test_dep.Create(t, frame)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		nil,
+		map[string]string{
+			"user_ids.#": "0",
+		},
+	)
+}
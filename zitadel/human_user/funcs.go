@@ -6,7 +6,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
@@ -25,10 +27,7 @@ func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	_, err = client.RemoveUser(helper.CtxWithOrgID(ctx, d), &management.RemoveUserRequest{
-		Id: d.Id(),
-	})
-	if err != nil {
+	if err := helper.DeactivateOrRemoveUser(helper.CtxWithOrgID(ctx, d), client, d, d.Get(userStateVar).(string)); err != nil {
 		return diag.Errorf("failed to delete user: %v", err)
 	}
 	return nil
@@ -47,6 +46,25 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
+	if helper.DeactivateOnDestroy(d) {
+		userID, err := helper.ReactivateDeactivatedUser(helper.CtxWithOrgID(ctx, d), clientinfo, client, d.Get(UserNameVar).(string), false)
+		if err != nil {
+			return diag.Errorf("failed to adopt deactivated human user: %v", err)
+		}
+		if userID != "" {
+			d.SetId(userID)
+			if _, ok := d.GetOk(DisplayNameVar); !ok {
+				if err := d.Set(DisplayNameVar, defaultDisplayName(d.Get(firstNameVar).(string), d.Get(lastNameVar).(string))); err != nil {
+					return diag.Errorf("failed to set default display name for human user: %v", err)
+				}
+			}
+			if diags := update(ctx, d, m); diags.HasError() {
+				return diags
+			}
+			return finishCreate(ctx, d, m, client)
+		}
+	}
+
 	firstName := d.Get(firstNameVar).(string)
 	lastName := d.Get(lastNameVar).(string)
 	importUser := &management.ImportHumanUserRequest{
@@ -76,7 +94,7 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		}
 	}
 
-	if email, ok := d.GetOk(emailVar); ok {
+	if email, ok := d.GetOk(EmailVar); ok {
 		isVerified, isVerifiedOk := d.GetOk(isEmailVerifiedVar)
 		importUser.Email = &management.ImportHumanUserRequest_Email{
 			Email:           email.(string),
@@ -103,9 +121,23 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.Errorf("failed to create human user: %v", err)
 	}
 	d.SetId(respUser.UserId)
+	return finishCreate(ctx, d, m, client)
+}
+
+// finishCreate changes the state of the active user to the desired state and reads the user
+func finishCreate(ctx context.Context, d *schema.ResourceData, m interface{}, client *mgmt.Client) diag.Diagnostics {
+	if state, ok := d.GetOk(userStateVar); ok {
+		if err := helper.ChangeUserState(helper.CtxWithOrgID(ctx, d), client, d.Id(), helper.UserStateValue(user.UserState_USER_STATE_ACTIVE), state.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	// To avoid diffs for terraform plan -refresh=false right after creation, we query and set the computed values.
 	// The acceptance tests rely on this, too.
-	return readFunc(false)(ctx, d, m)
+	diags := readFunc(false)(ctx, d, m)
+	if clientinfo, ok := m.(*helper.ClientInfo); ok && !diags.HasError() {
+		diags = append(diags, helper.AllowedLanguageWarning(ctx, clientinfo, d.Get(preferredLanguageVar).(string))...)
+	}
+	return diags
 }
 
 func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -126,7 +158,7 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			UserId:   d.Id(),
 			UserName: d.Get(UserNameVar).(string),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update username: %v", err)
 		}
 	}
@@ -141,18 +173,18 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			PreferredLanguage: d.Get(preferredLanguageVar).(string),
 			Gender:            user.Gender(user.Gender_value[d.Get(genderVar).(string)]),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update human profile: %v", err)
 		}
 	}
 
-	if d.HasChanges(emailVar, isEmailVerifiedVar) {
+	if d.HasChanges(EmailVar, isEmailVerifiedVar) {
 		_, err = client.UpdateHumanEmail(helper.CtxWithOrgID(ctx, d), &management.UpdateHumanEmailRequest{
 			UserId:          d.Id(),
-			Email:           d.Get(emailVar).(string),
+			Email:           d.Get(EmailVar).(string),
 			IsEmailVerified: d.Get(isEmailVerifiedVar).(bool),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update human email: %v", err)
 		}
 	}
@@ -163,10 +195,21 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			Phone:           d.Get(phoneVar).(string),
 			IsPhoneVerified: d.Get(isPhoneVerifiedVar).(bool),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update human phone: %v", err)
 		}
 	}
+
+	// on create, the state of an adopted user is changed from active after the update
+	if d.HasChange(userStateVar) && !d.IsNewResource() {
+		current, desired := d.GetChange(userStateVar)
+		if err := helper.ChangeUserState(helper.CtxWithOrgID(ctx, d), client, d.Id(), current.(string), desired.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange(preferredLanguageVar) && !d.IsNewResource() {
+		return helper.AllowedLanguageWarning(ctx, clientinfo, d.Get(preferredLanguageVar).(string))
+	}
 	return nil
 }
 
@@ -202,6 +245,7 @@ func readFunc(forDatasource bool) func(ctx context.Context, d *schema.ResourceDa
 			preferredLoginNameVar: user.GetPreferredLoginName(),
 		}
 		if !forDatasource {
+			set[userStateVar] = helper.UserStateValue(user.GetState())
 			// This will be ignored using the CustomizeDiff function.
 			// However, we should explicitly set it to true or false so that importing a user doesn't produce an immediate plan diff.
 			set[initialSkipPasswordChange] = false
@@ -219,7 +263,7 @@ func readFunc(forDatasource bool) func(ctx context.Context, d *schema.ResourceDa
 				}
 			}
 			if email := human.GetEmail(); email != nil {
-				set[emailVar] = email.GetEmail()
+				set[EmailVar] = email.GetEmail()
 				set[isEmailVerifiedVar] = email.GetIsEmailVerified()
 			}
 			if phone := human.GetPhone(); phone != nil {
@@ -240,3 +284,141 @@ func readFunc(forDatasource bool) func(ctx context.Context, d *schema.ResourceDa
 func defaultDisplayName(firstName, lastName string) string {
 	return firstName + " " + lastName
 }
+
+func get(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started get")
+
+	if _, ok := d.GetOk(UserIDVar); ok {
+		return readFunc(true)(ctx, d, m)
+	}
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var found *user.User
+	if loginName := d.Get(LoginNameVar).(string); loginName != "" {
+		resp, err := client.GetUserByLoginNameGlobal(ctx, &management.GetUserByLoginNameGlobalRequest{LoginName: loginName})
+		if err != nil {
+			return diag.Errorf("failed to get user by login name %s: %v", loginName, err)
+		}
+		if resp.GetUser().GetHuman() == nil {
+			return diag.Errorf("user with login name %s is not a human user", loginName)
+		}
+		found = resp.GetUser()
+	} else {
+		email := d.Get(EmailVar).(string)
+		resp, err := client.ListUsers(helper.CtxWithOrgID(ctx, d), &management.ListUsersRequest{
+			Queries: []*user.SearchQuery{
+				{Query: &user.SearchQuery_TypeQuery{TypeQuery: &user.TypeQuery{Type: user.Type_TYPE_HUMAN}}},
+				{Query: &user.SearchQuery_EmailQuery{EmailQuery: &user.EmailQuery{
+					EmailAddress: email,
+					Method:       object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS_IGNORE_CASE,
+				}}},
+			},
+		})
+		if err != nil {
+			return diag.Errorf("failed to get user by email %s: %v", email, err)
+		}
+		if len(resp.GetResult()) != 1 {
+			return diag.Errorf("expected exactly one human user with email %s, but found %d", email, len(resp.GetResult()))
+		}
+		found = resp.GetResult()[0]
+	}
+	set := map[string]interface{}{
+		UserIDVar:       found.GetId(),
+		helper.OrgIDVar: found.GetDetails().GetResourceOwner(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of user: %v", k, err)
+		}
+	}
+	return readFunc(true)(ctx, d, m)
+}
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &management.ListUsersRequest{
+		Queries: []*user.SearchQuery{{
+			Query: &user.SearchQuery_TypeQuery{TypeQuery: &user.TypeQuery{Type: user.Type_TYPE_HUMAN}},
+		}},
+	}
+	if userName := d.Get(UserNameVar).(string); userName != "" {
+		req.Queries = append(req.Queries, &user.SearchQuery{
+			Query: &user.SearchQuery_UserNameQuery{
+				UserNameQuery: &user.UserNameQuery{
+					UserName: userName,
+					Method:   object.TextQueryMethod(object.TextQueryMethod_value[d.Get(userNameMethodVar).(string)]),
+				},
+			},
+		})
+	}
+	if email := d.Get(EmailVar).(string); email != "" {
+		req.Queries = append(req.Queries, &user.SearchQuery{
+			Query: &user.SearchQuery_EmailQuery{
+				EmailQuery: &user.EmailQuery{
+					EmailAddress: email,
+					Method:       object.TextQueryMethod(object.TextQueryMethod_value[d.Get(emailMethodVar).(string)]),
+				},
+			},
+		})
+	}
+	if loginName := d.Get(LoginNameVar).(string); loginName != "" {
+		req.Queries = append(req.Queries, &user.SearchQuery{
+			Query: &user.SearchQuery_LoginNameQuery{
+				LoginNameQuery: &user.LoginNameQuery{
+					LoginName: loginName,
+					Method:    object.TextQueryMethod(object.TextQueryMethod_value[d.Get(loginNameMethodVar).(string)]),
+				},
+			},
+		})
+	}
+	if displayName := d.Get(DisplayNameVar).(string); displayName != "" {
+		req.Queries = append(req.Queries, &user.SearchQuery{
+			Query: &user.SearchQuery_DisplayNameQuery{
+				DisplayNameQuery: &user.DisplayNameQuery{
+					DisplayName: displayName,
+					Method:      object.TextQueryMethod(object.TextQueryMethod_value[d.Get(displayNameMethodVar).(string)]),
+				},
+			},
+		})
+	}
+	if state := d.Get(userStateVar).(string); state != "" {
+		req.Queries = append(req.Queries, &user.SearchQuery{
+			Query: &user.SearchQuery_StateQuery{
+				StateQuery: &user.StateQuery{
+					State: user.UserState(user.UserState_value[state]),
+				},
+			},
+		})
+	}
+	resp, err := client.ListUsers(helper.CtxWithOrgID(ctx, d), req)
+	if err != nil {
+		return diag.Errorf("error while listing human users: %v", err)
+	}
+	ids := make([]string, len(resp.Result))
+	for i, res := range resp.Result {
+		ids[i] = res.Id
+	}
+	// If the ID is blank, the datasource is deleted and not usable.
+	d.SetId("-")
+	return diag.FromErr(d.Set(userIDsVar, ids))
+}
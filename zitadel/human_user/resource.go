@@ -16,16 +16,9 @@ func GetResource() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource representing a human user situated under an organization, which then can be authorized through memberships or direct grants on other resources.",
 		Schema: map[string]*schema.Schema{
-			helper.OrgIDVar: helper.OrgIDResourceField,
-			userStateVar: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "State of the user",
-				/* Not necessary as long as only active users are created
-				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
-					return EnumValueValidation(userStateVar, value.(string), user.UserState_value)
-				},*/
-			},
+			helper.OrgIDVar:     helper.OrgIDResourceField,
+			helper.OnDestroyVar: helper.OnDestroyResourceField,
+			userStateVar:        helper.UserStateResourceField,
 			UserNameVar: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -83,7 +76,7 @@ func GetResource() *schema.Resource {
 				},
 				Default: defaultGenderString,
 			},
-			emailVar: {
+			EmailVar: {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "Email of the user",
@@ -156,6 +149,7 @@ func GetResource() *schema.Resource {
 			}, func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				return diff.SetNew(preferredLanguageVar, defaultPreferredLanguage)
 			}),
+			helper.CustomizeDiffUserState(userStateVar),
 		),
 		Importer: helper.ImportWithIDAndOptionalOrgAndSecret(UserIDVar, InitialPasswordVar),
 	}
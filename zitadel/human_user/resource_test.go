@@ -2,12 +2,14 @@ package human_user_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
@@ -40,6 +42,86 @@ func TestAccHumanUser(t *testing.T) {
 	)
 }
 
+func TestAccHumanUserState(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_human_user")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleUsername := test_utils.AttributeValue(t, human_user.UserNameVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, exampleUsername, frame.UniqueResourcesID, 1)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		test_utils.ReplaceAll(resourceExample, `"active"`, ""),
+		`"inactive"`, `"locked"`,
+		"", "", "",
+		false,
+		checkRemoteState(frame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteState(frame), ""),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportResourceId(frame.BaseTestFrame),
+			test_utils.ImportOrgId(frame),
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, human_user.InitialPasswordVar),
+		),
+	)
+}
+
+func TestAccHumanUserInitialState(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_human_user")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleUsername := test_utils.AttributeValue(t, human_user.UserNameVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, exampleUsername, frame.UniqueResourcesID, 1)
+	// a user without a password and a verified email is created in the initial state
+	for _, line := range []string{
+		`initial_password             = "Password1!"`,
+		`initial_skip_password_change = true`,
+		`is_email_verified            = true`,
+	} {
+		resourceExample = strings.Replace(resourceExample, line, "", 1)
+	}
+	exampleState := `state                        = "active"`
+	test_utils.RunStepsTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteState(frame), ""),
+		resource.TestStep{ // Check a user without a password is created in the initial state
+			Config: strings.Replace(resourceExample, exampleState, "", 1),
+			Check:  resource.TestCheckResourceAttr(frame.TerraformName, "state", "initial"),
+		},
+		resource.TestStep{ // Check deactivating the initial user fails at plan time
+			Config:      strings.Replace(resourceExample, exampleState, `state                        = "inactive"`, 1),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`a user in the initial state can't be set to inactive`),
+		},
+	)
+}
+
+func TestAccHumanUserOnDestroyDeactivate(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_human_user")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleUsername := test_utils.AttributeValue(t, human_user.UserNameVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, exampleUsername, frame.UniqueResourcesID, 1)
+	resourceExample = test_utils.WithOnDestroyDeactivate(resourceExample)
+	exampleProperty := test_utils.AttributeValue(t, human_user.DisplayNameVar, exampleAttributes).AsString()
+	test_utils.RunOnDestroyDeactivateTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		resourceExample, strings.Replace(resourceExample, exampleProperty, "updatedproperty", 1),
+		func(id string, active bool) error {
+			remoteResource, err := frame.GetUserByID(frame, &management.GetUserByIDRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			if actual := remoteResource.GetUser().GetState() != user.UserState_USER_STATE_INACTIVE; actual != active {
+				return fmt.Errorf("expected user to be active %t, but got state %s", active, remoteResource.GetUser().GetState())
+			}
+			return nil
+		},
+	)
+}
+
 func checkRemoteProperty(frame *test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
@@ -55,3 +137,19 @@ func checkRemoteProperty(frame *test_utils.OrgTestFrame) func(string) resource.T
 		}
 	}
 }
+
+func checkRemoteState(frame *test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			remoteResource, err := frame.GetUserByID(frame, &management.GetUserByIDRequest{Id: frame.State(state).ID})
+			if err != nil {
+				return err
+			}
+			actual := fmt.Sprintf(`"%s"`, helper.UserStateValue(remoteResource.GetUser().GetState()))
+			if actual != expect {
+				return fmt.Errorf("expected %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package human_users_import
+
+const (
+	FileVar        = "file"
+	FormatVar      = "format"
+	parallelismVar = "parallelism"
+	removeUsersVar = "remove_users_on_destroy"
+	UsersVar       = "users"
+	UserNameVar    = "user_name"
+	UserIDVar      = "user_id"
+	hashVar        = "hash"
+
+	formatCSV  = "csv"
+	formatJSON = "json"
+
+	defaultParallelism = 5
+	listPageSize       = 1000
+)
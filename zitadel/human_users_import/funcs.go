@@ -0,0 +1,338 @@
+package human_users_import
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+type stateUser struct {
+	userName, userID, hash string
+}
+
+func apply(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started apply")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	users, rowErrs, err := readUsers(d.Get(FileVar).(string), d.Get(FormatVar).(string))
+	if err != nil {
+		return diag.Errorf("failed to read users: %v", err)
+	}
+	var diags diag.Diagnostics
+	for _, rowErr := range rowErrs {
+		diags = append(diags, warning("skipped invalid user", rowErr))
+	}
+
+	previous := toStateUsers(d.Get(UsersVar))
+	orgCtx := helper.CtxWithOrgID(ctx, d)
+	results := make([]*stateUser, len(users))
+	errs := make([]error, len(users))
+	sem := make(chan struct{}, d.Get(parallelismVar).(int))
+	wg := sync.WaitGroup{}
+	for i, u := range users {
+		prev, imported := previous[u.UserName]
+		if imported && prev.hash == u.hash() {
+			results[i] = &prev
+			continue
+		}
+		wg.Add(1)
+		go func(i int, u importedUser, prev stateUser, imported bool) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if imported {
+				if err := updateUser(orgCtx, client, prev.userID, u); err != nil {
+					// The old hash is kept, so the update is retried on the next apply
+					results[i], errs[i] = &prev, err
+					return
+				}
+				results[i] = &stateUser{userName: u.UserName, userID: prev.userID, hash: u.hash()}
+				return
+			}
+			userID, err := importUser(orgCtx, client, u)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = &stateUser{userName: u.UserName, userID: userID, hash: u.hash()}
+		}(i, u, prev, imported)
+	}
+	wg.Wait()
+
+	var kept []stateUser
+	inFile := make(map[string]bool, len(users))
+	for i, u := range users {
+		inFile[u.UserName] = true
+		if errs[i] != nil {
+			diags = append(diags, warning(fmt.Sprintf("failed to import or update user %s", u.UserName), errs[i]))
+		}
+		if results[i] != nil {
+			kept = append(kept, *results[i])
+		}
+	}
+
+	var removed []string
+	for userName := range previous {
+		if !inFile[userName] {
+			removed = append(removed, userName)
+		}
+	}
+	sort.Strings(removed)
+	for _, userName := range removed {
+		prev := previous[userName]
+		// We can't tell if an invalid row belongs to a removed user, so we only delete users if all rows are valid
+		if len(rowErrs) > 0 {
+			kept = append(kept, prev)
+			continue
+		}
+		_, err := client.RemoveUser(orgCtx, &management.RemoveUserRequest{Id: prev.userID})
+		if err := helper.IgnoreIfNotFoundError(err); err != nil {
+			diags = append(diags, warning(fmt.Sprintf("failed to remove user %s", userName), err))
+			kept = append(kept, prev)
+		}
+	}
+	if len(rowErrs) > 0 && len(removed) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%d users which are not in the file anymore are not removed", len(removed)),
+			Detail:   "Users are only removed if all rows in the file are valid",
+		})
+	}
+
+	if d.Id() == "" {
+		d.SetId(id.UniqueId())
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].userName < kept[j].userName })
+	if err := d.Set(UsersVar, fromStateUsers(kept)); err != nil {
+		return append(diags, diag.Errorf("failed to set %s: %v", UsersVar, err)...)
+	}
+	return diags
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing := make(map[string]bool)
+	for offset := uint64(0); ; offset += listPageSize {
+		resp, err := client.ListUsers(helper.CtxWithOrgID(ctx, d), &management.ListUsersRequest{
+			Query: &object.ListQuery{Offset: offset, Limit: listPageSize},
+			Queries: []*user.SearchQuery{{
+				Query: &user.SearchQuery_TypeQuery{TypeQuery: &user.TypeQuery{Type: user.Type_TYPE_HUMAN}},
+			}},
+		})
+		if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return diag.Errorf("failed to list users: %v", err)
+		}
+		for _, u := range resp.GetResult() {
+			existing[u.GetId()] = true
+		}
+		if len(resp.GetResult()) < listPageSize {
+			break
+		}
+	}
+
+	// Users which were removed outside of Terraform are dropped from the state, so they are imported again
+	var kept []stateUser
+	for _, u := range toStateUsers(d.Get(UsersVar)) {
+		if existing[u.userID] {
+			kept = append(kept, u)
+		}
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].userName < kept[j].userName })
+	if err := d.Set(UsersVar, fromStateUsers(kept)); err != nil {
+		return diag.Errorf("failed to set %s: %v", UsersVar, err)
+	}
+	return nil
+}
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+	if !d.Get(removeUsersVar).(bool) {
+		tflog.Info(ctx, "keeping the imported users")
+		return nil
+	}
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	for _, u := range toStateUsers(d.Get(UsersVar)) {
+		_, err := client.RemoveUser(helper.CtxWithOrgID(ctx, d), &management.RemoveUserRequest{Id: u.userID})
+		if err := helper.IgnoreIfNotFoundError(err); err != nil {
+			diags = append(diags, diag.Errorf("failed to remove user %s: %v", u.userName, err)...)
+		}
+	}
+	return diags
+}
+
+// customizeDiff plans an update if the file contains new or changed users or if users were removed from the file
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	users, rowErrs, err := readUsers(d.Get(FileVar).(string), d.Get(FormatVar).(string))
+	if err != nil {
+		return fmt.Errorf("failed to read users: %v", err)
+	}
+	previous := toStateUsers(d.Get(UsersVar))
+	changed := len(rowErrs) == 0 && len(users) != len(previous)
+	for _, u := range users {
+		if prev, ok := previous[u.UserName]; !ok || prev.hash != u.hash() {
+			changed = true
+			break
+		}
+	}
+	if changed {
+		return d.SetNewComputed(UsersVar)
+	}
+	return nil
+}
+
+func importUser(ctx context.Context, client *mgmt.Client, u importedUser) (string, error) {
+	req := &management.ImportHumanUserRequest{
+		UserName: u.UserName,
+		Profile: &management.ImportHumanUserRequest_Profile{
+			FirstName:         u.FirstName,
+			LastName:          u.LastName,
+			NickName:          u.NickName,
+			DisplayName:       u.displayName(),
+			PreferredLanguage: u.PreferredLanguage,
+			Gender:            u.gender(),
+		},
+		Email: &management.ImportHumanUserRequest_Email{
+			Email:           u.Email,
+			IsEmailVerified: u.IsEmailVerified,
+		},
+		Password:               u.InitialPassword,
+		PasswordChangeRequired: !u.InitialSkipPasswordChange,
+	}
+	if u.InitialHashedPassword != "" {
+		req.HashedPassword = &management.ImportHumanUserRequest_HashedPassword{Value: u.InitialHashedPassword}
+	}
+	if u.Phone != "" {
+		req.Phone = &management.ImportHumanUserRequest_Phone{
+			Phone:           u.Phone,
+			IsPhoneVerified: u.IsPhoneVerified,
+		}
+	}
+	resp, err := client.ImportHumanUser(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.GetUserId(), nil
+}
+
+// updateUser ignores precondition errors, because ZITADEL returns them if a value didn't change
+func updateUser(ctx context.Context, client *mgmt.Client, userID string, u importedUser) error {
+	_, err := client.UpdateHumanProfile(ctx, &management.UpdateHumanProfileRequest{
+		UserId:            userID,
+		FirstName:         u.FirstName,
+		LastName:          u.LastName,
+		NickName:          u.NickName,
+		DisplayName:       u.displayName(),
+		PreferredLanguage: u.PreferredLanguage,
+		Gender:            u.gender(),
+	})
+	if err := helper.IgnorePreconditionError(err); err != nil {
+		return fmt.Errorf("failed to update profile: %v", err)
+	}
+	_, err = client.UpdateHumanEmail(ctx, &management.UpdateHumanEmailRequest{
+		UserId:          userID,
+		Email:           u.Email,
+		IsEmailVerified: u.IsEmailVerified,
+	})
+	if err := helper.IgnorePreconditionError(err); err != nil {
+		return fmt.Errorf("failed to update email: %v", err)
+	}
+	if u.Phone == "" {
+		_, err = client.RemoveHumanPhone(ctx, &management.RemoveHumanPhoneRequest{UserId: userID})
+		if err := helper.IgnoreIfNotFoundError(helper.IgnorePreconditionError(err)); err != nil {
+			return fmt.Errorf("failed to remove phone: %v", err)
+		}
+		return nil
+	}
+	_, err = client.UpdateHumanPhone(ctx, &management.UpdateHumanPhoneRequest{
+		UserId:          userID,
+		Phone:           u.Phone,
+		IsPhoneVerified: u.IsPhoneVerified,
+	})
+	if err := helper.IgnorePreconditionError(err); err != nil {
+		return fmt.Errorf("failed to update phone: %v", err)
+	}
+	return nil
+}
+
+func warning(summary string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   err.Error(),
+	}
+}
+
+func toStateUsers(raw interface{}) map[string]stateUser {
+	users := make(map[string]stateUser)
+	for _, item := range raw.([]interface{}) {
+		u := item.(map[string]interface{})
+		users[u[UserNameVar].(string)] = stateUser{
+			userName: u[UserNameVar].(string),
+			userID:   u[UserIDVar].(string),
+			hash:     u[hashVar].(string),
+		}
+	}
+	return users
+}
+
+func fromStateUsers(users []stateUser) []interface{} {
+	raw := make([]interface{}, len(users))
+	for i, u := range users {
+		raw[i] = map[string]interface{}{
+			UserNameVar: u.userName,
+			UserIDVar:   u.userID,
+			hashVar:     u.hash,
+		}
+	}
+	return raw
+}
@@ -0,0 +1,89 @@
+package human_users_import
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Imports many human users from a CSV or JSON file into an organization. " +
+			"Only the user name, ID and a content hash of each user are kept in the state, so changed rows are updated, new rows are imported and removed rows are deleted on the next apply. " +
+			"Destroying the resource keeps the imported users, unless remove_users_on_destroy is set. " +
+			"Rows which fail are reported as warnings without aborting the rest of the batch and are retried on the next apply. " +
+			"The initial password fields are only used when a user is imported.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDResourceField,
+			FileVar: {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Path to the file containing the users. " +
+					"Each user has the attributes user_name, first_name, last_name, nick_name, display_name, preferred_language, gender, email, is_email_verified, phone, is_phone_verified, initial_password, initial_hashed_password and initial_skip_password_change. " +
+					"A CSV file needs a header row with the attribute names, a JSON file contains an array of objects. " +
+					"The initial_hashed_password supports the bcrypt, argon2 and PBKDF2 formats",
+			},
+			FormatVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Format of the file, supported values: " + formatCSV + ", " + formatJSON,
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					switch value.(string) {
+					case formatCSV, formatJSON:
+						return nil
+					}
+					return diag.Errorf("%s can only be %s or %s", FormatVar, formatCSV, formatJSON)
+				},
+			},
+			parallelismVar: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     defaultParallelism,
+				Description: "Maximum number of users which are imported or updated at the same time",
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					if value.(int) < 1 {
+						return diag.Errorf("%s must be at least 1", parallelismVar)
+					}
+					return nil
+				},
+			},
+			removeUsersVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the imported users when the resource is destroyed. By default, the users are kept, so removing a finished import from the configuration doesn't delete the imported users",
+			},
+			UsersVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The imported users",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						UserNameVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Username",
+						},
+						UserIDVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the user",
+						},
+						hashVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Hash of the imported attributes, used to detect changed rows",
+						},
+					},
+				},
+			},
+		},
+		CreateContext: apply,
+		UpdateContext: apply,
+		ReadContext:   read,
+		DeleteContext: delete,
+		CustomizeDiff: customizeDiff,
+	}
+}
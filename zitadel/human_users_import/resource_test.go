@@ -0,0 +1,114 @@
+package human_users_import_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+)
+
+const (
+	exampleFile        = "${path.module}/users.csv"
+	exampleDisplayName = "displayname"
+	exampleParallelism = "parallelism = 10"
+)
+
+func TestAccHumanUsersImport(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_human_users_import")
+	resourceExample, _ := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	users, userName := exampleUsers(t, frame)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		usersConfig(t, resourceExample, users),
+		exampleDisplayName, "updateddisplayname",
+		"", "", "",
+		false,
+		checkRemoteProperty(frame, userName),
+		regexp.MustCompile(`^.+$`),
+		// destroying the import keeps the users
+		checkRemoteProperty(frame, userName)("updateddisplayname"),
+		nil,
+	)
+}
+
+func TestAccHumanUsersImportRemoveUsersOnDestroy(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_human_users_import")
+	resourceExample, _ := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	resourceExample = strings.Replace(resourceExample, exampleParallelism, exampleParallelism+"\n  remove_users_on_destroy = true", 1)
+	users, userName := exampleUsers(t, frame)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		usersConfig(t, resourceExample, users),
+		exampleDisplayName, "updateddisplayname",
+		"", "", "",
+		false,
+		checkRemoteProperty(frame, userName),
+		regexp.MustCompile(`^.+$`),
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(frame, userName), ""),
+		nil,
+	)
+}
+
+// usersConfig writes the users with the display name of the first user to a file and returns the example importing the file
+func usersConfig(t *testing.T, resourceExample, users string) func(string, string) string {
+	dir := t.TempDir()
+	return func(displayName, _ string) string {
+		file := filepath.Join(dir, displayName+".csv")
+		if err := os.WriteFile(file, []byte(strings.Replace(users, exampleDisplayName, displayName, 1)), 0600); err != nil {
+			t.Fatalf("failed to write users: %v", err)
+		}
+		return strings.Replace(resourceExample, exampleFile, file, 1)
+	}
+}
+
+// exampleUsers returns the example users with user names which are unique on the instance and the user name of the first user
+func exampleUsers(t *testing.T, frame *test_utils.OrgTestFrame) (string, string) {
+	exampleCSV, err := os.ReadFile(filepath.Join("..", "..", "examples", "provider", "resources", "users.csv"))
+	if err != nil {
+		t.Fatalf("failed to read example users: %v", err)
+	}
+	users := strings.ReplaceAll(string(exampleCSV), "@example.com,", "-"+frame.UniqueResourcesID+"@example.com,")
+	return users, "alice-" + frame.UniqueResourcesID + "@example.com"
+}
+
+func checkRemoteProperty(frame *test_utils.OrgTestFrame, userName string) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.ListUsers(frame, &management.ListUsersRequest{
+				Queries: []*user.SearchQuery{{
+					Query: &user.SearchQuery_UserNameQuery{
+						UserNameQuery: &user.UserNameQuery{
+							UserName: userName,
+							Method:   object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+						},
+					},
+				}},
+			})
+			if err != nil {
+				return err
+			}
+			if len(resp.GetResult()) == 0 {
+				return fmt.Errorf("user %s: %w", userName, test_utils.ErrNotFound)
+			}
+			actual := resp.GetResult()[0].GetHuman().GetProfile().GetDisplayName()
+			if actual != expect {
+				return fmt.Errorf("expected %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
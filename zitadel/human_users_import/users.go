@@ -0,0 +1,226 @@
+package human_users_import
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
+)
+
+// hashedPasswordPrefixes are the modular crypt format identifiers of the supported bcrypt, argon2 and PBKDF2 hashes
+var hashedPasswordPrefixes = []string{"$2a$", "$2b$", "$2y$", "$argon2i$", "$argon2id$", "$pbkdf2$", "$pbkdf2-sha1$", "$pbkdf2-sha224$", "$pbkdf2-sha256$", "$pbkdf2-sha384$", "$pbkdf2-sha512$"}
+
+type importedUser struct {
+	UserName                  string `json:"user_name"`
+	FirstName                 string `json:"first_name"`
+	LastName                  string `json:"last_name"`
+	NickName                  string `json:"nick_name"`
+	DisplayName               string `json:"display_name"`
+	PreferredLanguage         string `json:"preferred_language"`
+	Gender                    string `json:"gender"`
+	Email                     string `json:"email"`
+	IsEmailVerified           bool   `json:"is_email_verified"`
+	Phone                     string `json:"phone"`
+	IsPhoneVerified           bool   `json:"is_phone_verified"`
+	InitialPassword           string `json:"initial_password"`
+	InitialHashedPassword     string `json:"initial_hashed_password"`
+	InitialSkipPasswordChange bool   `json:"initial_skip_password_change"`
+}
+
+type rowError struct {
+	row int
+	err error
+}
+
+func (r rowError) Error() string {
+	return fmt.Sprintf("row %d: %v", r.row, r.err)
+}
+
+// readUsers parses the file and validates each user.
+// Invalid rows are returned as errors, so the valid rows can still be imported.
+func readUsers(file, format string) ([]importedUser, []rowError, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open %s: %v", file, err)
+	}
+	defer f.Close()
+	var (
+		users  []importedUser
+		errs   []rowError
+		parsed map[int]importedUser
+	)
+	switch format {
+	case formatCSV:
+		parsed, errs, err = parseCSV(f)
+	case formatJSON:
+		parsed, errs, err = parseJSON(f)
+	default:
+		err = fmt.Errorf("unsupported format %s", format)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	seen := make(map[string]int)
+	rows := len(parsed) + len(errs)
+	for row := 1; row <= rows; row++ {
+		u, ok := parsed[row]
+		if !ok {
+			continue
+		}
+		if err := u.validate(); err != nil {
+			errs = append(errs, rowError{row: row, err: err})
+			continue
+		}
+		if first, ok := seen[u.UserName]; ok {
+			errs = append(errs, rowError{row: row, err: fmt.Errorf("user name %s is already used in row %d", u.UserName, first)})
+			continue
+		}
+		seen[u.UserName] = row
+		users = append(users, u)
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].row < errs[j].row })
+	return users, errs, nil
+}
+
+func parseCSV(r io.Reader) (map[int]importedUser, []rowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read csv header: %v", err)
+	}
+	users := make(map[int]importedUser)
+	var errs []rowError
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errs = append(errs, rowError{row: row, err: err})
+			continue
+		}
+		values, err := csvValues(header, record)
+		if err != nil {
+			errs = append(errs, rowError{row: row, err: err})
+			continue
+		}
+		u, err := decodeUser(values)
+		if err != nil {
+			errs = append(errs, rowError{row: row, err: err})
+			continue
+		}
+		users[row] = u
+	}
+	return users, errs, nil
+}
+
+// csvValues maps the record to the header columns, empty values are skipped and the boolean columns are parsed
+func csvValues(header, record []string) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(header))
+	for i, column := range header {
+		if i >= len(record) || record[i] == "" {
+			continue
+		}
+		column = strings.TrimSpace(column)
+		switch column {
+		case "is_email_verified", "is_phone_verified", "initial_skip_password_change":
+			b, err := strconv.ParseBool(record[i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %v", column, err)
+			}
+			values[column] = b
+		default:
+			values[column] = record[i]
+		}
+	}
+	return values, nil
+}
+
+func parseJSON(r io.Reader) (map[int]importedUser, []rowError, error) {
+	var entries []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, nil, fmt.Errorf("failed to read json array: %v", err)
+	}
+	users := make(map[int]importedUser)
+	var errs []rowError
+	for i, entry := range entries {
+		var values map[string]interface{}
+		if err := json.Unmarshal(entry, &values); err != nil {
+			errs = append(errs, rowError{row: i + 1, err: err})
+			continue
+		}
+		u, err := decodeUser(values)
+		if err != nil {
+			errs = append(errs, rowError{row: i + 1, err: err})
+			continue
+		}
+		users[i+1] = u
+	}
+	return users, errs, nil
+}
+
+// decodeUser rejects unknown attributes, so typos in column names don't silently drop values
+func decodeUser(values map[string]interface{}) (importedUser, error) {
+	u := importedUser{}
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return u, err
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(raw)))
+	decoder.DisallowUnknownFields()
+	return u, decoder.Decode(&u)
+}
+
+func (u importedUser) validate() error {
+	required := map[string]string{"user_name": u.UserName, "first_name": u.FirstName, "last_name": u.LastName, "email": u.Email}
+	for _, attribute := range []string{"user_name", "first_name", "last_name", "email"} {
+		if required[attribute] == "" {
+			return fmt.Errorf("%s is required", attribute)
+		}
+	}
+	if _, ok := user.Gender_value[u.Gender]; u.Gender != "" && !ok {
+		return fmt.Errorf("unsupported gender %s", u.Gender)
+	}
+	if u.InitialPassword != "" && u.InitialHashedPassword != "" {
+		return fmt.Errorf("only one of initial_password and initial_hashed_password can be set")
+	}
+	if u.InitialHashedPassword != "" {
+		for _, prefix := range hashedPasswordPrefixes {
+			if strings.HasPrefix(u.InitialHashedPassword, prefix) {
+				return nil
+			}
+		}
+		return fmt.Errorf("initial_hashed_password must be a bcrypt, argon2 or PBKDF2 hash")
+	}
+	return nil
+}
+
+// hash covers all attributes which are updated after the import, so the initial passwords are excluded
+func (u importedUser) hash() string {
+	u.InitialPassword = ""
+	u.InitialHashedPassword = ""
+	u.InitialSkipPasswordChange = false
+	raw, _ := json.Marshal(u)
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func (u importedUser) displayName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	return u.FirstName + " " + u.LastName
+}
+
+func (u importedUser) gender() user.Gender {
+	return user.Gender(user.Gender_value[u.Gender])
+}
@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &initMessageTextResource{}
+	_ resource.Resource               = &initMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &initMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -78,6 +79,10 @@ func (r *initMessageTextResource) Configure(_ context.Context, req resource.Conf
 	r.clientInfo = clientInfo
 }
 
+func (r *initMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *initMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	orgID, language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -398,4 +403,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
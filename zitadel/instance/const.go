@@ -0,0 +1,22 @@
+package instance
+
+const (
+	InstanceIDVar                  = "id"
+	instanceIDsVar                 = "ids"
+	NameVar                        = "name"
+	FirstOrgNameVar                = "first_org_name"
+	CustomDomainVar                = "custom_domain"
+	DefaultLanguageVar             = "default_language"
+	OwnerUserNameVar               = "owner_user_name"
+	OwnerEmailVar                  = "owner_email"
+	OwnerEmailVerifiedVar          = "owner_email_verified"
+	OwnerFirstNameVar              = "owner_first_name"
+	OwnerLastNameVar               = "owner_last_name"
+	OwnerPasswordVar               = "owner_password"
+	OwnerPasswordChangeRequiredVar = "owner_password_change_required"
+	stateVar                       = "state"
+	domainsVar                     = "domains"
+	primaryDomainVar               = "primary_domain"
+	versionVar                     = "version"
+	DomainVar                      = "domain"
+)
@@ -0,0 +1,74 @@
+package instance
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/instance"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing a ZITADEL instance, which is read through the system API.",
+		Schema: map[string]*schema.Schema{
+			InstanceIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the instance",
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					_, err := helper.ConvertID(i.(string))
+					return diag.FromErr(err)
+				},
+			},
+			NameVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the instance",
+			},
+			stateVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the instance" + helper.DescriptionEnumValuesList(instance.State_name),
+			},
+			domainsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All domains of the instance",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			primaryDomainVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Primary domain of the instance",
+			},
+			versionVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ZITADEL version the instance was set up with",
+			},
+		},
+		ReadContext: get,
+	}
+}
+
+func ListDatasources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing the instances of a ZITADEL system, which are listed through the system API.",
+		Schema: map[string]*schema.Schema{
+			instanceIDsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of all instance IDs.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			DomainVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A domain of the instance.",
+			},
+		},
+		ReadContext: list,
+	}
+}
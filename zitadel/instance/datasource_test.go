@@ -0,0 +1,59 @@
+package instance_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance/instance_test_dep"
+)
+
+func TestAccInstanceDatasource_ID(t *testing.T) {
+	datasourceName := "zitadel_instance"
+	frame := test_utils.NewSystemTestFrame(t, datasourceName)
+	instanceName := "instance_datasource_" + frame.UniqueResourcesID
+	config, instanceID := instance_test_dep.Create(t, frame, instanceName)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		nil,
+		nil,
+		map[string]string{
+			"id":    instanceID,
+			"name":  instanceName,
+			"state": "STATE_RUNNING",
+		},
+	)
+}
+
+func TestAccInstancesDatasources_Domain_Match(t *testing.T) {
+	datasourceName := "zitadel_instances"
+	frame := test_utils.NewSystemTestFrame(t, datasourceName)
+	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	exampleDomain := test_utils.AttributeValue(t, instance.DomainVar, attributes).AsString()
+	_, instanceID := instance_test_dep.Create(t, frame, "instances_datasource_"+frame.UniqueResourcesID)
+	domain := fmt.Sprintf("%s.%s", strings.ToLower(frame.UniqueResourcesID), frame.InstanceDomain)
+	if _, err := frame.AddDomain(frame, &system.AddDomainRequest{InstanceId: instanceID, Domain: domain}); err != nil {
+		t.Fatalf("failed to add instance domain: %v", err)
+	}
+	// for-each is not supported in acceptance tests, so we cut the example down to the first block
+	// https://github.com/hashicorp/terraform-plugin-sdk/issues/536
+	config = strings.Join(strings.Split(config, "\n")[0:3], "\n")
+	config = strings.Replace(config, exampleDomain, domain, 1)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		nil,
+		nil,
+		map[string]string{
+			"ids.0": instanceID,
+			"ids.#": "1",
+		},
+	)
+}
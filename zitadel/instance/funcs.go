@@ -0,0 +1,231 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/instance"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.RemoveInstance(ctx, &system.RemoveInstanceRequest{
+		InstanceId: d.Id(),
+	})
+	if err != nil {
+		return diag.Errorf("failed to delete instance: %v", err)
+	}
+	return nil
+}
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &system.AddInstanceRequest{
+		InstanceName:    d.Get(NameVar).(string),
+		FirstOrgName:    d.Get(FirstOrgNameVar).(string),
+		CustomDomain:    d.Get(CustomDomainVar).(string),
+		DefaultLanguage: d.Get(DefaultLanguageVar).(string),
+		OwnerUserName:   d.Get(OwnerUserNameVar).(string),
+		OwnerEmail: &system.AddInstanceRequest_Email{
+			Email:           d.Get(OwnerEmailVar).(string),
+			IsEmailVerified: d.Get(OwnerEmailVerifiedVar).(bool),
+		},
+		OwnerProfile: &system.AddInstanceRequest_Profile{
+			FirstName: d.Get(OwnerFirstNameVar).(string),
+			LastName:  d.Get(OwnerLastNameVar).(string),
+		},
+	}
+	if password := d.Get(OwnerPasswordVar).(string); password != "" {
+		req.OwnerPassword = &system.AddInstanceRequest_Password{
+			Password:               password,
+			PasswordChangeRequired: d.Get(OwnerPasswordChangeRequiredVar).(bool),
+		}
+	}
+
+	resp, err := client.AddInstance(ctx, req)
+	if err != nil {
+		return diag.Errorf("failed to create instance: %v", err)
+	}
+	d.SetId(resp.GetInstanceId())
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(NameVar) {
+		_, err = client.UpdateInstance(ctx, &system.UpdateInstanceRequest{
+			InstanceId:   d.Id(),
+			InstanceName: d.Get(NameVar).(string),
+		})
+		if err != nil {
+			return diag.Errorf("failed to update instance: %v", err)
+		}
+	}
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetInstance(ctx, &system.GetInstanceRequest{
+		InstanceId: d.Id(),
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get instance: %v", err)
+	}
+
+	remoteInstance := resp.GetInstance()
+	domains, primaryDomain := instanceDomains(remoteInstance.GetDomains())
+	set := map[string]interface{}{
+		NameVar:          remoteInstance.GetName(),
+		stateVar:         remoteInstance.GetState().String(),
+		domainsVar:       domains,
+		primaryDomainVar: primaryDomain,
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of instance: %v", k, err)
+		}
+	}
+	d.SetId(remoteInstance.GetId())
+	return nil
+}
+
+func get(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started get")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get(InstanceIDVar).(string)
+	resp, err := client.GetInstance(ctx, &system.GetInstanceRequest{
+		InstanceId: instanceID,
+	})
+	if err != nil {
+		return diag.Errorf("failed to get instance %s: %v", instanceID, err)
+	}
+
+	remoteInstance := resp.GetInstance()
+	domains, primaryDomain := instanceDomains(remoteInstance.GetDomains())
+	set := map[string]interface{}{
+		NameVar:          remoteInstance.GetName(),
+		stateVar:         remoteInstance.GetState().String(),
+		domainsVar:       domains,
+		primaryDomainVar: primaryDomain,
+		versionVar:       remoteInstance.GetVersion(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of instance: %v", k, err)
+		}
+	}
+	d.SetId(remoteInstance.GetId())
+	return nil
+}
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &system.ListInstancesRequest{}
+	if domain := d.Get(DomainVar).(string); domain != "" {
+		req.Queries = append(req.Queries, &instance.Query{
+			Query: &instance.Query_DomainQuery{
+				DomainQuery: &instance.DomainsQuery{
+					Domains: []string{domain},
+				},
+			},
+		})
+	}
+	resp, err := client.ListInstances(ctx, req)
+	if err != nil {
+		return diag.Errorf("failed to list instances: %v", err)
+	}
+	instanceIDs := make([]string, len(resp.GetResult()))
+	for i, remoteInstance := range resp.GetResult() {
+		instanceIDs[i] = remoteInstance.GetId()
+	}
+	// If the ID is blank, the datasource is deleted and not usable.
+	d.SetId("-")
+	return diag.FromErr(d.Set(instanceIDsVar, instanceIDs))
+}
+
+func instanceDomains(remoteDomains []*instance.Domain) ([]string, string) {
+	domains := make([]string, len(remoteDomains))
+	primaryDomain := ""
+	for i, domain := range remoteDomains {
+		domains[i] = domain.GetDomain()
+		if domain.GetPrimary() {
+			primaryDomain = domain.GetDomain()
+		}
+	}
+	return domains, primaryDomain
+}
@@ -0,0 +1,24 @@
+package instance_test_dep
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance"
+)
+
+func Create(t *testing.T, frame *test_utils.SystemTestFrame, name string) (string, string) {
+	return test_utils.CreateDefaultDependency(t,
+		"zitadel_instance",
+		instance.InstanceIDVar,
+		func() (string, error) {
+			i, err := frame.AddInstance(frame, &system.AddInstanceRequest{
+				InstanceName: name,
+				OwnerEmail:   &system.AddInstanceRequest_Email{Email: "admin@example.com"},
+				OwnerProfile: &system.AddInstanceRequest_Profile{FirstName: "Instance", LastName: "Admin"},
+			})
+			return i.GetInstanceId(), err
+		})
+}
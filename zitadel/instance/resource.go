@@ -0,0 +1,117 @@
+package instance
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/instance"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing a ZITADEL instance, which is managed through the system API. The provider has to be configured with a system API user to manage instances. The first organization and the human owner are only considered on creation.",
+		Schema: map[string]*schema.Schema{
+			NameVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the instance",
+			},
+			FirstOrgNameVar: {
+				Type:     schema.TypeString,
+				Optional: true,
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+				Description:      "Name of the first organization of the instance",
+			},
+			CustomDomainVar: {
+				Type:     schema.TypeString,
+				Optional: true,
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+				Description:      "Custom domain added to the instance on creation, additional domains can be managed with the zitadel_instance_domain resource",
+			},
+			DefaultLanguageVar: {
+				Type:     schema.TypeString,
+				Optional: true,
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+				Description:      "Default language of the instance",
+			},
+			OwnerUserNameVar: {
+				Type:     schema.TypeString,
+				Optional: true,
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+				Description:      "Username of the human owner of the instance",
+			},
+			OwnerEmailVar: {
+				Type:     schema.TypeString,
+				Required: true,
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+				Description:      "Email of the human owner of the instance",
+			},
+			OwnerEmailVerifiedVar: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+				Description:      "Is the email of the human owner verified",
+			},
+			OwnerFirstNameVar: {
+				Type:     schema.TypeString,
+				Required: true,
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+				Description:      "First name of the human owner of the instance",
+			},
+			OwnerLastNameVar: {
+				Type:     schema.TypeString,
+				Required: true,
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+				Description:      "Last name of the human owner of the instance",
+			},
+			OwnerPasswordVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Initial password of the human owner of the instance, not changeable after creation",
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+			},
+			OwnerPasswordChangeRequiredVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the human owner has to change the password on first login",
+				// We ignore if the value changes after creation or import
+				DiffSuppressFunc: ignoreAfterCreation,
+			},
+			stateVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the instance" + helper.DescriptionEnumValuesList(instance.State_name),
+			},
+			domainsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All domains of the instance",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			primaryDomainVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Primary domain of the instance",
+			},
+		},
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Importer:      helper.ImportWithIDAndOptionalSecret(InstanceIDVar, OwnerPasswordVar),
+	}
+}
+
+func ignoreAfterCreation(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
@@ -0,0 +1,62 @@
+package instance_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance"
+)
+
+func TestAccInstance(t *testing.T) {
+	frame := test_utils.NewSystemTestFrame(t, "zitadel_instance")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleDomain := test_utils.AttributeValue(t, instance.CustomDomainVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, exampleDomain, fmt.Sprintf("%s.%s", strings.ToLower(frame.UniqueResourcesID), frame.InstanceDomain), 1)
+	exampleProperty := test_utils.AttributeValue(t, instance.NameVar, exampleAttributes).AsString()
+	updatedProperty := "updatedProperty"
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(frame),
+		test_utils.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(frame), ""),
+		test_utils.ImportResourceId(frame.BaseTestFrame),
+		instance.FirstOrgNameVar,
+		instance.CustomDomainVar,
+		instance.DefaultLanguageVar,
+		instance.OwnerUserNameVar,
+		instance.OwnerEmailVar,
+		instance.OwnerEmailVerifiedVar,
+		instance.OwnerFirstNameVar,
+		instance.OwnerLastNameVar,
+		instance.OwnerPasswordVar,
+		instance.OwnerPasswordChangeRequiredVar,
+	)
+}
+
+func checkRemoteProperty(frame *test_utils.SystemTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.GetInstance(frame, &system.GetInstanceRequest{InstanceId: frame.State(state).ID})
+			if err != nil {
+				return err
+			}
+			actual := resp.GetInstance().GetName()
+			if actual != expect {
+				return fmt.Errorf("expected %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
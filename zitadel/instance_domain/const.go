@@ -0,0 +1,8 @@
+package instance_domain
+
+const (
+	InstanceIDVar  = "instance_id"
+	DomainVar      = "domain"
+	isPrimaryVar   = "is_primary"
+	isGeneratedVar = "is_generated"
+)
@@ -0,0 +1,149 @@
+package instance_domain
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/instance"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.RemoveDomain(ctx, &system.RemoveDomainRequest{
+		InstanceId: d.Get(InstanceIDVar).(string),
+		Domain:     d.Id(),
+	})
+	if err != nil {
+		return diag.Errorf("failed to delete instance domain: %v", err)
+	}
+	return nil
+}
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get(InstanceIDVar).(string)
+	domain := d.Get(DomainVar).(string)
+	_, err = client.AddDomain(ctx, &system.AddDomainRequest{
+		InstanceId: instanceID,
+		Domain:     domain,
+	})
+	if err != nil {
+		return diag.Errorf("failed to create instance domain: %v", err)
+	}
+	d.SetId(domain)
+	if d.Get(isPrimaryVar).(bool) {
+		_, err = client.SetPrimaryDomain(ctx, &system.SetPrimaryDomainRequest{
+			InstanceId: instanceID,
+			Domain:     domain,
+		})
+		if err != nil {
+			return diag.Errorf("failed to set instance domain primary: %v", err)
+		}
+	}
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(isPrimaryVar) && d.Get(isPrimaryVar).(bool) {
+		_, err = client.SetPrimaryDomain(ctx, &system.SetPrimaryDomainRequest{
+			InstanceId: d.Get(InstanceIDVar).(string),
+			Domain:     d.Id(),
+		})
+		if err != nil {
+			return diag.Errorf("failed to set instance domain primary: %v", err)
+		}
+	}
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ListDomains(ctx, &system.ListDomainsRequest{
+		InstanceId: d.Get(InstanceIDVar).(string),
+		Queries: []*instance.DomainSearchQuery{{
+			Query: &instance.DomainSearchQuery_DomainQuery{
+				DomainQuery: &instance.DomainQuery{
+					Domain: d.Id(),
+					Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to list instance domains: %v", err)
+	}
+
+	if len(resp.GetResult()) == 1 {
+		domain := resp.GetResult()[0]
+		set := map[string]interface{}{
+			DomainVar:      domain.GetDomain(),
+			isPrimaryVar:   domain.GetPrimary(),
+			isGeneratedVar: domain.GetGenerated(),
+		}
+		for k, v := range set {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("failed to set %s of instance domain: %v", k, err)
+			}
+		}
+		d.SetId(domain.GetDomain())
+		return nil
+	}
+
+	d.SetId("")
+	return nil
+}
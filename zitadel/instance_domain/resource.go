@@ -0,0 +1,52 @@
+package instance_domain
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing a custom domain of a ZITADEL instance, which is managed through the system API.",
+		Schema: map[string]*schema.Schema{
+			InstanceIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the instance",
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					_, err := helper.ConvertID(i.(string))
+					return diag.FromErr(err)
+				},
+			},
+			DomainVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Custom domain of the instance",
+			},
+			isPrimaryVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Is the domain the primary domain of the instance",
+			},
+			isGeneratedVar: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the domain generated by ZITADEL",
+			},
+		},
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Importer: helper.ImportWithAttributes(
+			helper.NewImportAttribute(DomainVar, helper.ConvertNonEmpty, false),
+			helper.NewImportAttribute(InstanceIDVar, helper.ConvertID, false),
+		),
+	}
+}
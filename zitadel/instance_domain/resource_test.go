@@ -0,0 +1,58 @@
+package instance_domain_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance/instance_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_domain"
+)
+
+func TestAccInstanceDomain(t *testing.T) {
+	frame := test_utils.NewSystemTestFrame(t, "zitadel_instance_domain")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, instance_domain.DomainVar, exampleAttributes).AsString()
+	uniqueDomain := fmt.Sprintf("%s.%s", strings.ToLower(frame.UniqueResourcesID), frame.InstanceDomain)
+	updatedProperty := "updated-" + uniqueDomain
+	instanceDep, instanceID := instance_test_dep.Create(t, frame, "instance_domain_"+frame.UniqueResourcesID)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{instanceDep},
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		uniqueDomain, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(frame, instanceID),
+		regexp.MustCompile(fmt.Sprintf(`^(%s|%s)$`, regexp.QuoteMeta(uniqueDomain), regexp.QuoteMeta(updatedProperty))),
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(frame, instanceID), updatedProperty),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportResourceId(frame.BaseTestFrame),
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, instance_domain.InstanceIDVar),
+		),
+	)
+}
+
+func checkRemoteProperty(frame *test_utils.SystemTestFrame, instanceID string) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.ListDomains(frame, &system.ListDomainsRequest{InstanceId: instanceID})
+			if err != nil {
+				return err
+			}
+			for _, domain := range resp.GetResult() {
+				if domain.GetDomain() == expect {
+					return nil
+				}
+			}
+			return fmt.Errorf("expected domain %s: %w", expect, test_utils.ErrNotFound)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package instance_limits
+
+const (
+	InstanceIDVar        = "instance_id"
+	AuditLogRetentionVar = "audit_log_retention"
+	BlockVar             = "block"
+)
@@ -0,0 +1,100 @@
+package instance_limits
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.ResetLimits(ctx, &system.ResetLimitsRequest{
+		InstanceId: d.Id(),
+	})
+	if helper.IgnoreIfNotFoundError(err) != nil {
+		return diag.Errorf("failed to reset instance limits: %v", err)
+	}
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get(InstanceIDVar).(string)
+	req := &system.SetLimitsRequest{
+		InstanceId: instanceID,
+	}
+	if retention := d.Get(AuditLogRetentionVar).(string); retention != "" {
+		duration, err := time.ParseDuration(retention)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.AuditLogRetention = durationpb.New(duration)
+	}
+	block := d.Get(BlockVar).(bool)
+	req.Block = &block
+
+	if _, err = client.SetLimits(ctx, req); err != nil {
+		return diag.Errorf("failed to set instance limits: %v", err)
+	}
+	d.SetId(instanceID)
+	return nil
+}
+
+// read only checks that the instance still exists, as the system API doesn't offer reading limits
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.GetInstance(ctx, &system.GetInstanceRequest{
+		InstanceId: d.Id(),
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get instance of limits: %v", err)
+	}
+	if err := d.Set(InstanceIDVar, d.Id()); err != nil {
+		return diag.Errorf("failed to set %s of instance limits: %v", InstanceIDVar, err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package instance_limits
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the limits of a ZITADEL instance, which are managed through the system API. As limits can't be read from the system API, changes done outside of Terraform are not detected. Deleting the resource resets the limits.",
+		Schema: map[string]*schema.Schema{
+			InstanceIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the instance",
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					_, err := helper.ConvertID(i.(string))
+					return diag.FromErr(err)
+				},
+			},
+			AuditLogRetentionVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Duration for which the audit log of the instance is queryable, e.g. 720h",
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					if _, err := time.ParseDuration(value.(string)); err != nil {
+						return diag.FromErr(fmt.Errorf("%s must be a duration: %w", AuditLogRetentionVar, err))
+					}
+					return nil
+				},
+			},
+			BlockVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Block all requests to the instance, except for the system API",
+			},
+		},
+		CreateContext: update,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Importer:      helper.ImportWithID(InstanceIDVar),
+	}
+}
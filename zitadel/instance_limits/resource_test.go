@@ -0,0 +1,40 @@
+package instance_limits_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance/instance_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_limits"
+)
+
+func TestAccInstanceLimits(t *testing.T) {
+	frame := test_utils.NewSystemTestFrame(t, "zitadel_instance_limits")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, instance_limits.AuditLogRetentionVar, exampleAttributes).AsString()
+	instanceDep, _ := instance_test_dep.Create(t, frame, "instance_limits_"+frame.UniqueResourcesID)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{instanceDep},
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, "24h",
+		"", "", "",
+		false,
+		// limits can't be read from the system API, so we can only check the state
+		checkStateProperty(frame),
+		test_utils.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckNothing,
+		test_utils.ImportResourceId(frame.BaseTestFrame),
+		instance_limits.AuditLogRetentionVar,
+		instance_limits.BlockVar,
+	)
+}
+
+func checkStateProperty(frame *test_utils.SystemTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return resource.TestCheckResourceAttr(frame.TerraformName, instance_limits.AuditLogRetentionVar, expect)
+	}
+}
@@ -141,3 +141,8 @@ func splitInstanceMemberID(memberID string) (string, string) {
 	parts := strings.Split(memberID, "_")
 	return parts[0], parts[1]
 }
+
+// customizeDiff validates the roles against the instance member roles at plan time
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return helper.ValidateMemberRoles(ctx, d, m, helper.MemberRoleScopeInstance, RolesVar)
+}
@@ -22,13 +22,14 @@ func GetResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 				Required:    true,
-				Description: "List of roles granted, full list available here: https://zitadel.com/docs/guides/manage/console/managers#roles",
+				Description: "List of roles granted, full list available here: https://zitadel.com/docs/guides/manage/console/managers#roles, the roles are validated at plan time against zitadel_member_roles with scope instance",
 			},
 		},
 		DeleteContext: delete,
 		CreateContext: create,
 		UpdateContext: update,
 		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
 		Importer:      helper.ImportWithEmptyID(helper.NewImportAttribute(UserIDVar, helper.ConvertID, false)),
 	}
 }
@@ -0,0 +1,14 @@
+package instance_quota
+
+const (
+	InstanceIDVar    = "instance_id"
+	UnitVar          = "unit"
+	FromVar          = "from"
+	ResetIntervalVar = "reset_interval"
+	AmountVar        = "amount"
+	LimitVar         = "limit"
+	NotificationsVar = "notifications"
+	percentVar       = "percent"
+	repeatVar        = "repeat"
+	callURLVar       = "call_url"
+)
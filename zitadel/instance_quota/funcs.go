@@ -0,0 +1,149 @@
+package instance_quota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/quota"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/system"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.RemoveQuota(ctx, &system.RemoveQuotaRequest{
+		InstanceId: d.Get(InstanceIDVar).(string),
+		Unit:       quota.Unit(quota.Unit_value[d.Get(UnitVar).(string)]),
+	})
+	if helper.IgnoreIfNotFoundError(err) != nil {
+		return diag.Errorf("failed to delete instance quota: %v", err)
+	}
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	from, err := time.Parse(time.RFC3339, d.Get(FromVar).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resetInterval, err := time.ParseDuration(d.Get(ResetIntervalVar).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	notifications := make([]*quota.Notification, 0)
+	for _, notification := range d.Get(NotificationsVar).([]interface{}) {
+		notificationMap := notification.(map[string]interface{})
+		notifications = append(notifications, &quota.Notification{
+			Percent: uint32(notificationMap[percentVar].(int)),
+			Repeat:  notificationMap[repeatVar].(bool),
+			CallUrl: notificationMap[callURLVar].(string),
+		})
+	}
+
+	unit := d.Get(UnitVar).(string)
+	_, err = client.SetQuota(ctx, &system.SetQuotaRequest{
+		InstanceId:    d.Get(InstanceIDVar).(string),
+		Unit:          quota.Unit(quota.Unit_value[unit]),
+		From:          timestamppb.New(from),
+		ResetInterval: durationpb.New(resetInterval),
+		Amount:        uint64(d.Get(AmountVar).(int)),
+		Limit:         d.Get(LimitVar).(bool),
+		Notifications: notifications,
+	})
+	if err != nil {
+		return diag.Errorf("failed to set instance quota: %v", err)
+	}
+	d.SetId(quotaID(d.Get(InstanceIDVar).(string), unit))
+	return nil
+}
+
+// read only checks that the instance still exists, as the system API doesn't offer reading quotas
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetSystemClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.GetInstance(ctx, &system.GetInstanceRequest{
+		InstanceId: d.Get(InstanceIDVar).(string),
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get instance of quota: %v", err)
+	}
+	return nil
+}
+
+// importState expects the import ID format <instance_id:unit>.
+// The other attributes can't be read from the system API, so the next apply sets the quota as configured.
+func importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	states, err := helper.ImportWithID(InstanceIDVar, helper.NewImportAttribute(UnitVar, convertUnit, false)).StateContext(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+	instanceID := d.Id()
+	if err := d.Set(InstanceIDVar, instanceID); err != nil {
+		return nil, fmt.Errorf("failed to set %s=%s: %w", InstanceIDVar, instanceID, err)
+	}
+	d.SetId(quotaID(instanceID, d.Get(UnitVar).(string)))
+	return states, nil
+}
+
+func convertUnit(unit string) (interface{}, error) {
+	if _, ok := quota.Unit_value[unit]; !ok || unit == quota.Unit_UNIT_UNIMPLEMENTED.String() {
+		return nil, fmt.Errorf("unit %s is not supported", unit)
+	}
+	return unit, nil
+}
+
+// quotaID scopes the ID to the instance, as every instance can have a quota per unit
+func quotaID(instanceID, unit string) string {
+	return instanceID + ":" + unit
+}
+
+func validatePositive(key string, value interface{}) diag.Diagnostics {
+	if value.(int) < 1 {
+		return diag.Errorf("%s must be at least 1", key)
+	}
+	return nil
+}
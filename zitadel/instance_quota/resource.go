@@ -0,0 +1,110 @@
+package instance_quota
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/quota"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing a quota of a ZITADEL instance, which is managed through the system API. As quotas can't be read from the system API, changes done outside of Terraform are not detected.",
+		Schema: map[string]*schema.Schema{
+			InstanceIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the instance",
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					_, err := helper.ConvertID(i.(string))
+					return diag.FromErr(err)
+				},
+			},
+			UnitVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unit of the quota" + helper.DescriptionEnumValuesList(quota.Unit_name),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					if value.(string) == quota.Unit_UNIT_UNIMPLEMENTED.String() {
+						return diag.Errorf("%s must be set to an implemented unit", UnitVar)
+					}
+					return helper.EnumValueValidation(UnitVar, value, quota.Unit_value)
+				},
+			},
+			FromVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Start of the first quota period as RFC3339 timestamp, e.g. 2024-01-01T00:00:00Z",
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					if _, err := time.Parse(time.RFC3339, value.(string)); err != nil {
+						return diag.FromErr(fmt.Errorf("%s must be a RFC3339 timestamp: %w", FromVar, err))
+					}
+					return nil
+				},
+			},
+			ResetIntervalVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Interval after which the quota is reset, e.g. 720h",
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					if _, err := time.ParseDuration(value.(string)); err != nil {
+						return diag.FromErr(fmt.Errorf("%s must be a duration: %w", ResetIntervalVar, err))
+					}
+					return nil
+				},
+			},
+			AmountVar: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Amount of the unit which is allowed per reset interval",
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return validatePositive(AmountVar, value)
+				},
+			},
+			LimitVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Block further usage if the amount is exhausted",
+			},
+			NotificationsVar: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Notifications which are sent when a percentage of the amount is used",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						percentVar: {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Percentage of the amount which triggers the notification",
+							ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+								return validatePositive(percentVar, value)
+							},
+						},
+						repeatVar: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Repeat the notification for every multiple of the percentage",
+						},
+						callURLVar: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL which is called with the notification",
+						},
+					},
+				},
+			},
+		},
+		CreateContext: update,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Importer:      &schema.ResourceImporter{StateContext: importState},
+	}
+}
@@ -0,0 +1,47 @@
+package instance_quota_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance/instance_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_quota"
+)
+
+func TestAccInstanceQuota(t *testing.T) {
+	frame := test_utils.NewSystemTestFrame(t, "zitadel_instance_quota")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, instance_quota.ResetIntervalVar, exampleAttributes).AsString()
+	unit := test_utils.AttributeValue(t, instance_quota.UnitVar, exampleAttributes).AsString()
+	instanceDep, instanceID := instance_test_dep.Create(t, frame, "instance_quota_"+frame.UniqueResourcesID)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{instanceDep},
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, "24h",
+		"", "", "",
+		false,
+		// quotas can't be read from the system API, so we can only check the state
+		checkStateProperty(frame),
+		regexp.MustCompile(fmt.Sprintf("^%s:%s$", instanceID, unit)),
+		test_utils.CheckNothing,
+		test_utils.ImportResourceId(frame.BaseTestFrame),
+		// quotas can't be read from the system API, so only the ID attributes are imported
+		instance_quota.FromVar,
+		instance_quota.ResetIntervalVar,
+		instance_quota.AmountVar,
+		instance_quota.LimitVar,
+		instance_quota.NotificationsVar,
+	)
+}
+
+func checkStateProperty(frame *test_utils.SystemTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return resource.TestCheckResourceAttr(frame.TerraformName, instance_quota.ResetIntervalVar, expect)
+	}
+}
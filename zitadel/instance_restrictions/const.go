@@ -0,0 +1,6 @@
+package instance_restrictions
+
+const (
+	DisallowPublicOrgRegistrationVar = "disallow_public_org_registration"
+	AllowedLanguagesVar              = "allowed_languages"
+)
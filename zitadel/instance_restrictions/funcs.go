@@ -0,0 +1,132 @@
+package instance_restrictions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	disallowPublicOrgRegistration := false
+	_, err = client.SetRestrictions(ctx, &admin.SetRestrictionsRequest{
+		DisallowPublicOrgRegistration: &disallowPublicOrgRegistration,
+		AllowedLanguages:              &admin.SelectLanguages{List: []string{}},
+	})
+	if helper.IgnorePreconditionError(err) != nil {
+		return diag.Errorf("failed to reset instance restrictions: %v", err)
+	}
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	disallowPublicOrgRegistration := d.Get(DisallowPublicOrgRegistrationVar).(bool)
+	resp, err := client.SetRestrictions(ctx, &admin.SetRestrictionsRequest{
+		DisallowPublicOrgRegistration: &disallowPublicOrgRegistration,
+		AllowedLanguages: &admin.SelectLanguages{
+			List: helper.GetOkSetToStringSlice(d, AllowedLanguagesVar),
+		},
+	})
+	if helper.IgnorePreconditionError(err) != nil {
+		return diag.Errorf("failed to set instance restrictions: %v", err)
+	}
+	if resp != nil {
+		d.SetId(resp.GetDetails().GetResourceOwner())
+		return nil
+	}
+	return read(ctx, d, m)
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetRestrictions(ctx, &admin.GetRestrictionsRequest{})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get instance restrictions")
+	}
+
+	set := map[string]interface{}{
+		DisallowPublicOrgRegistrationVar: resp.GetDisallowPublicOrgRegistration(),
+		AllowedLanguagesVar:              resp.GetAllowedLanguages(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of instance restrictions: %v", k, err)
+		}
+	}
+	d.SetId(resp.GetDetails().GetResourceOwner())
+	return nil
+}
+
+// customizeDiff validates the allowed languages against the languages supported by ZITADEL
+func customizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.HasChange(AllowedLanguagesVar) {
+		return nil
+	}
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return nil
+	}
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetSupportedLanguages(ctx, &admin.GetSupportedLanguagesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get supported languages: %v", err)
+	}
+	supported := make(map[string]bool, len(resp.GetLanguages()))
+	for _, language := range resp.GetLanguages() {
+		supported[language] = true
+	}
+	for _, language := range helper.SetToStringSlice(diff.Get(AllowedLanguagesVar).(*schema.Set)) {
+		if !supported[language] {
+			return fmt.Errorf(`attribute %s contains unsupported language "%s", supported languages are: %s`, AllowedLanguagesVar, language, strings.Join(resp.GetLanguages(), ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package instance_restrictions
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the restrictions of an instance, like the allowed languages and whether organizations can be registered publicly.",
+		Schema: map[string]*schema.Schema{
+			DisallowPublicOrgRegistrationVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disallow the public registration of new organizations",
+			},
+			AllowedLanguagesVar: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Languages which are allowed to be used in the instance, e.g. in login texts, message texts and as preferred language of users. If empty, all supported languages are allowed.",
+			},
+		},
+		CreateContext: update,
+		UpdateContext: update,
+		DeleteContext: delete,
+		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
+		Importer:      helper.ImportWithEmptyID(),
+	}
+}
@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &loginTextsResource{}
+	_ resource.Resource               = &loginTextsResource{}
+	_ resource.ResourceWithModifyPlan = &loginTextsResource{}
 )
 
 func New() resource.Resource {
@@ -78,6 +79,10 @@ func (r *loginTextsResource) Configure(_ context.Context, req resource.Configure
 	r.clientInfo = clientInfo
 }
 
+func (r *loginTextsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *loginTextsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	orgID, language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -396,4 +401,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
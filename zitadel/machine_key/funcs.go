@@ -2,6 +2,7 @@ package machine_key
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -9,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/authn"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
@@ -60,13 +60,11 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		req.PublicKey = []byte(publicKey.(string))
 	}
 
-	if expiration, ok := d.GetOk(ExpirationDateVar); ok {
-		t, err := time.Parse(time.RFC3339, expiration.(string))
-		if err != nil {
-			return diag.Errorf("failed to parse time: %v", err)
-		}
-		req.ExpirationDate = timestamppb.New(t)
+	expirationDate, err := helper.GetExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	req.ExpirationDate = expirationDate
 
 	resp, err := client.AddMachineKey(helper.CtxWithOrgID(ctx, d), req)
 	if err != nil {
@@ -78,6 +76,12 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			return diag.FromErr(err)
 		}
 	}
+	// The expiration date is needed in the state to plan the rotation, also if it is computed from the lifetime
+	if expirationDate != nil {
+		if err := d.Set(ExpirationDateVar, expirationDate.AsTime().Format(time.RFC3339)); err != nil {
+			return diag.Errorf("failed to set %s of machine key: %v", ExpirationDateVar, err)
+		}
+	}
 	return nil
 }
 
@@ -109,15 +113,36 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 
 	d.SetId(resp.GetKey().GetId())
 	set := map[string]interface{}{
-		ExpirationDateVar: resp.GetKey().GetExpirationDate().AsTime().Format(time.RFC3339),
-		UserIDVar:         userID,
-		helper.OrgIDVar:   orgID,
-		keyTypeVar:        resp.GetKey().GetType().String(),
+		ExpirationDateVar:   resp.GetKey().GetExpirationDate().AsTime().Format(time.RFC3339),
+		helper.ExpiresInVar: helper.ExpiresIn(resp.GetKey().GetExpirationDate().AsTime()),
+		UserIDVar:           userID,
+		helper.OrgIDVar:     orgID,
+		keyTypeVar:          resp.GetKey().GetType().String(),
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
 			return diag.Errorf("failed to set %s of machine key: %v", k, err)
 		}
 	}
-	return nil
+	return helper.ExpiryWarning(d, "machine key", resp.GetKey().GetExpirationDate().AsTime())
+}
+
+// customizeDiff plans the replacement of the machine key if it is due for rotation
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return helper.CustomizeDiffRotateBefore(ctx, d, m, creationDate, KeyDetailsVar)
+}
+
+func creationDate(ctx context.Context, d *schema.ResourceDiff, clientinfo *helper.ClientInfo) (time.Time, error) {
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return time.Time{}, err
+	}
+	resp, err := client.GetMachineKeyByIDs(helper.CtxSetOrgID(ctx, d.Get(helper.OrgIDVar).(string)), &management.GetMachineKeyByIDsRequest{
+		UserId: d.Get(UserIDVar).(string),
+		KeyId:  d.Id(),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get machine key: %v", err)
+	}
+	return resp.GetKey().GetDetails().GetCreationDate().AsTime(), nil
 }
@@ -36,12 +36,16 @@ func GetResource() *schema.Resource {
 				ForceNew:    true,
 			},
 			ExpirationDateVar: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Expiration date of the machine key in the RFC3339 format",
-				ForceNew:    true,
-				Computed:    true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Expiration date of the machine key in the RFC3339 format",
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{helper.LifetimeVar},
 			},
+			helper.LifetimeVar:     helper.LifetimeResourceField,
+			helper.RotateBeforeVar: helper.RotateBeforeResourceField,
+			helper.ExpiresInVar:    helper.ExpiresInResourceField,
 			KeyDetailsVar: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -52,6 +56,9 @@ func GetResource() *schema.Resource {
 		DeleteContext: delete,
 		CreateContext: create,
 		ReadContext:   read,
+		// lifetime and rotate_before only affect future replacements, so an update only refreshes the state
+		UpdateContext: read,
+		CustomizeDiff: customizeDiff,
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			keyIDVar,
 			helper.NewImportAttribute(UserIDVar, helper.ConvertID, false),
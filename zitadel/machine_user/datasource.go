@@ -58,7 +58,7 @@ func GetDatasource() *schema.Resource {
 				Computed:    true,
 				Description: "Access token type",
 			}},
-		ReadContext: read,
+		ReadContext: readFunc(true),
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user"
@@ -26,10 +27,7 @@ func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	_, err = client.RemoveUser(helper.CtxWithOrgID(ctx, d), &management.RemoveUserRequest{
-		Id: d.Id(),
-	})
-	if err != nil {
+	if err := helper.DeactivateOrRemoveUser(helper.CtxWithOrgID(ctx, d), client, d, d.Get(userStateVar).(string)); err != nil {
 		return diag.Errorf("failed to delete user: %v", err)
 	}
 	return nil
@@ -48,35 +46,47 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	respUser, err := client.AddMachineUser(helper.CtxWithOrgID(ctx, d), &management.AddMachineUserRequest{
-		UserName:        d.Get(UserNameVar).(string),
-		Name:            d.Get(nameVar).(string),
-		Description:     d.Get(DescriptionVar).(string),
-		AccessTokenType: user.AccessTokenType(user.AccessTokenType_value[(d.Get(accessTokenTypeVar).(string))]),
-	})
-	if err != nil {
-		return diag.Errorf("failed to create machine user: %v", err)
+	userID := ""
+	if helper.DeactivateOnDestroy(d) {
+		userID, err = helper.ReactivateDeactivatedUser(helper.CtxWithOrgID(ctx, d), clientinfo, client, d.Get(UserNameVar).(string), true)
+		if err != nil {
+			return diag.Errorf("failed to adopt deactivated machine user: %v", err)
+		}
 	}
-	d.SetId(respUser.UserId)
-
-	if d.Get(WithSecretVar).(bool) {
-		resp, err := client.GenerateMachineSecret(helper.CtxWithOrgID(ctx, d), &management.GenerateMachineSecretRequest{
-			UserId: respUser.UserId,
+	if userID != "" {
+		d.SetId(userID)
+		// the update applies the configuration to the adopted user, including its secret
+		if diags := update(ctx, d, m); diags.HasError() {
+			return diags
+		}
+	} else {
+		respUser, err := client.AddMachineUser(helper.CtxWithOrgID(ctx, d), &management.AddMachineUserRequest{
+			UserName:        d.Get(UserNameVar).(string),
+			Name:            d.Get(nameVar).(string),
+			Description:     d.Get(DescriptionVar).(string),
+			AccessTokenType: user.AccessTokenType(user.AccessTokenType_value[(d.Get(accessTokenTypeVar).(string))]),
 		})
 		if err != nil {
-			return diag.Errorf("failed to generate machine user secret: %v", err)
+			return diag.Errorf("failed to create machine user: %v", err)
 		}
-		if err := d.Set(clientIDVar, resp.GetClientId()); err != nil {
-			return diag.Errorf("failed to set %s of user: %v", clientIDVar, err)
+		d.SetId(respUser.UserId)
+
+		if d.Get(WithSecretVar).(bool) {
+			if diags := generateSecret(ctx, client, d); diags.HasError() {
+				return diags
+			}
 		}
-		if err := d.Set(clientSecretVar, resp.GetClientSecret()); err != nil {
-			return diag.Errorf("failed to set %s of user: %v", clientSecretVar, err)
+	}
+
+	if state, ok := d.GetOk(userStateVar); ok {
+		if err := helper.ChangeUserState(helper.CtxWithOrgID(ctx, d), client, d.Id(), helper.UserStateValue(user.UserState_USER_STATE_ACTIVE), state.(string)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	// To avoid diffs for terraform plan -refresh=false right after creation, we query and set the computed values.
 	// The acceptance tests rely on this, too.
-	return read(ctx, d, m)
+	return readFunc(false)(ctx, d, m)
 }
 
 func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -97,7 +107,7 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			UserId:   d.Id(),
 			UserName: d.Get(UserNameVar).(string),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update username: %v", err)
 		}
 	}
@@ -109,24 +119,18 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			Description:     d.Get(DescriptionVar).(string),
 			AccessTokenType: user.AccessTokenType(user.AccessTokenType_value[(d.Get(accessTokenTypeVar).(string))]),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update machine user: %v", err)
 		}
 	}
 
-	if d.HasChange(WithSecretVar) {
+	generatedAt, _ := d.GetChange(helper.SecretGeneratedAtVar)
+	rotate := d.HasChange(helper.SecretRotationTriggersVar) || helper.SecretRotationDue(generatedAt.(string), d.Get(helper.RotateAfterVar).(string))
+	if d.HasChange(WithSecretVar) || rotate && d.Get(WithSecretVar).(bool) {
 		if d.Get(WithSecretVar).(bool) {
-			resp, err := client.GenerateMachineSecret(helper.CtxWithOrgID(ctx, d), &management.GenerateMachineSecretRequest{
-				UserId: d.Id(),
-			})
-			if err != nil {
-				return diag.Errorf("failed to generate machine user secret: %v", err)
-			}
-			if err := d.Set(clientIDVar, resp.GetClientId()); err != nil {
-				return diag.Errorf("failed to set %s of user: %v", clientIDVar, err)
-			}
-			if err := d.Set(clientSecretVar, resp.GetClientSecret()); err != nil {
-				return diag.Errorf("failed to set %s of user: %v", clientSecretVar, err)
+			// generating a new secret replaces the current one
+			if diags := generateSecret(ctx, client, d); diags.HasError() {
+				return diags
 			}
 		} else {
 			_, err := client.RemoveMachineSecret(helper.CtxWithOrgID(ctx, d), &management.RemoveMachineSecretRequest{
@@ -141,53 +145,69 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			if err := d.Set(clientSecretVar, ""); err != nil {
 				return diag.Errorf("failed to set %s of user: %v", clientSecretVar, err)
 			}
+			if err := d.Set(helper.SecretGeneratedAtVar, ""); err != nil {
+				return diag.Errorf("failed to set %s of user: %v", helper.SecretGeneratedAtVar, err)
+			}
+		}
+	}
+
+	// on create, the state of an adopted user is changed from active after the update
+	if d.HasChange(userStateVar) && !d.IsNewResource() {
+		current, desired := d.GetChange(userStateVar)
+		if err := helper.ChangeUserState(helper.CtxWithOrgID(ctx, d), client, d.Id(), current.(string), desired.(string)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	tflog.Info(ctx, "started read")
+func readFunc(forDatasource bool) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		tflog.Info(ctx, "started read")
 
-	clientinfo, ok := m.(*helper.ClientInfo)
-	if !ok {
-		return diag.Errorf("failed to get client")
-	}
+		clientinfo, ok := m.(*helper.ClientInfo)
+		if !ok {
+			return diag.Errorf("failed to get client")
+		}
 
-	client, err := helper.GetManagementClient(ctx, clientinfo)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+		client, err := helper.GetManagementClient(ctx, clientinfo)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	respUser, err := client.GetUserByID(helper.CtxWithOrgID(ctx, d), &management.GetUserByIDRequest{Id: helper.GetID(d, UserIDVar)})
-	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
-		d.SetId("")
-		return nil
-	}
-	if err != nil {
-		return diag.Errorf("failed to get user")
-	}
+		respUser, err := client.GetUserByID(helper.CtxWithOrgID(ctx, d), &management.GetUserByIDRequest{Id: helper.GetID(d, UserIDVar)})
+		if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return diag.Errorf("failed to get user")
+		}
 
-	user := respUser.GetUser()
-	set := map[string]interface{}{
-		helper.OrgIDVar:       user.GetDetails().GetResourceOwner(),
-		userStateVar:          user.GetState().String(),
-		UserNameVar:           user.GetUserName(),
-		loginNamesVar:         user.GetLoginNames(),
-		preferredLoginNameVar: user.GetPreferredLoginName(),
-	}
-	if machine := user.GetMachine(); machine != nil {
-		set[nameVar] = machine.GetName()
-		set[DescriptionVar] = machine.GetDescription()
-		set[accessTokenTypeVar] = machine.GetAccessTokenType().String()
-	}
-	for k, v := range set {
-		if err := d.Set(k, v); err != nil {
-			return diag.Errorf("failed to set %s of user: %v", k, err)
+		user := respUser.GetUser()
+		set := map[string]interface{}{
+			helper.OrgIDVar:       user.GetDetails().GetResourceOwner(),
+			userStateVar:          user.GetState().String(),
+			UserNameVar:           user.GetUserName(),
+			loginNamesVar:         user.GetLoginNames(),
+			preferredLoginNameVar: user.GetPreferredLoginName(),
+		}
+		if machine := user.GetMachine(); machine != nil {
+			set[nameVar] = machine.GetName()
+			set[DescriptionVar] = machine.GetDescription()
+			set[accessTokenTypeVar] = machine.GetAccessTokenType().String()
+		}
+		if !forDatasource {
+			set[userStateVar] = helper.UserStateValue(user.GetState())
+		}
+		for k, v := range set {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("failed to set %s of user: %v", k, err)
+			}
 		}
+		d.SetId(user.GetId())
+		return nil
 	}
-	d.SetId(user.GetId())
-	return nil
 }
 
 func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -226,3 +246,31 @@ func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	d.SetId("-")
 	return diag.FromErr(d.Set(userIDsVar, ids))
 }
+
+func generateSecret(ctx context.Context, client *mgmt.Client, d *schema.ResourceData) diag.Diagnostics {
+	resp, err := client.GenerateMachineSecret(helper.CtxWithOrgID(ctx, d), &management.GenerateMachineSecretRequest{
+		UserId: d.Id(),
+	})
+	if err != nil {
+		return diag.Errorf("failed to generate machine user secret: %v", err)
+	}
+	set := map[string]interface{}{
+		clientIDVar:                 resp.GetClientId(),
+		clientSecretVar:             resp.GetClientSecret(),
+		helper.SecretGeneratedAtVar: helper.SecretGeneratedAt(),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of user: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// customizeDiff plans a new client secret if the rotation triggers changed or if the secret is due for rotation
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.Get(WithSecretVar).(bool) || d.HasChange(WithSecretVar) {
+		return nil
+	}
+	return helper.CustomizeDiffSecretRotation(d, clientSecretVar)
+}
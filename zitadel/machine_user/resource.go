@@ -13,16 +13,9 @@ func GetResource() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource representing a serviceaccount situated under an organization, which then can be authorized through memberships or direct grants on other resources.",
 		Schema: map[string]*schema.Schema{
-			helper.OrgIDVar: helper.OrgIDResourceField,
-			userStateVar: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "State of the user",
-				/* Not necessary as long as only active users are created
-				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
-					return EnumValueValidation(userStateVar, value.(string), user.UserState_value)
-				},*/
-			},
+			helper.OrgIDVar:     helper.OrgIDResourceField,
+			helper.OnDestroyVar: helper.OnDestroyResourceField,
+			userStateVar:        helper.UserStateResourceField,
 			UserNameVar: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -66,6 +59,9 @@ func GetResource() *schema.Resource {
 				Default:     false,
 				Description: "Generate machine secret, only applicable if creation or change from false",
 			},
+			helper.SecretRotationTriggersVar: helper.SecretRotationTriggersResourceField,
+			helper.RotateAfterVar:            helper.RotateAfterResourceField,
+			helper.SecretGeneratedAtVar:      helper.SecretGeneratedAtResourceField,
 			clientIDVar: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -79,10 +75,11 @@ func GetResource() *schema.Resource {
 				Sensitive:   true,
 			},
 		},
-		ReadContext:   read,
+		ReadContext:   readFunc(false),
 		CreateContext: create,
 		DeleteContext: delete,
 		UpdateContext: update,
+		CustomizeDiff: customizeDiff,
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			UserIDVar,
 			helper.NewImportAttribute(WithSecretVar, helper.ConvertBool, false),
@@ -42,6 +42,50 @@ func TestAccMachineUser(t *testing.T) {
 	)
 }
 
+func TestAccMachineUserState(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_machine_user")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleUsername := test_utils.AttributeValue(t, machine_user.UserNameVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, exampleUsername, frame.UniqueResourcesID, 1)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		test_utils.ReplaceAll(resourceExample, `"active"`, ""),
+		`"inactive"`, `"locked"`,
+		"", "", "",
+		false,
+		checkRemoteState(frame),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteState(frame), ""),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportResourceId(frame.BaseTestFrame),
+			func(state *terraform.State) (string, error) {
+				return strconv.FormatBool(test_utils.AttributeValue(t, machine_user.WithSecretVar, exampleAttributes).True()), nil
+			},
+			test_utils.ImportOrgId(frame),
+		),
+	)
+}
+
+func TestAccMachineUserSecretRotation(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_machine_user")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleUsername := test_utils.AttributeValue(t, machine_user.UserNameVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, exampleUsername, frame.UniqueResourcesID, 1)
+	resourceExample = strings.Replace(resourceExample, "with_secret = false", "with_secret = true", 1)
+	test_utils.RunSecretRotationTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		func(trigger string) string {
+			return test_utils.WithSecretRotationTrigger(resourceExample, trigger)
+		},
+		"client_secret",
+		resource.TestCheckResourceAttrSet(frame.TerraformName, helper.SecretGeneratedAtVar),
+	)
+}
+
 func checkRemoteProperty(frame *test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
@@ -57,3 +101,19 @@ func checkRemoteProperty(frame *test_utils.OrgTestFrame) func(string) resource.T
 		}
 	}
 }
+
+func checkRemoteState(frame *test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			remoteResource, err := frame.GetUserByID(frame, &management.GetUserByIDRequest{Id: frame.State(state).ID})
+			if err != nil {
+				return err
+			}
+			actual := fmt.Sprintf(`"%s"`, helper.UserStateValue(remoteResource.GetUser().GetState()))
+			if actual != expect {
+				return fmt.Errorf("expected %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
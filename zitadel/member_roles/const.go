@@ -0,0 +1,6 @@
+package member_roles
+
+const (
+	ScopeVar = "scope"
+	RolesVar = "roles"
+)
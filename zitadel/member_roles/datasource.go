@@ -0,0 +1,39 @@
+package member_roles
+
+import (
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing the roles which can be given to members of the instance, organizations, projects or project grants.",
+		Schema: map[string]*schema.Schema{
+			ScopeVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Scope of the memberships, supported values: " + strings.Join(helper.MemberRoleScopes, ", "),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					for _, scope := range helper.MemberRoleScopes {
+						if value.(string) == scope {
+							return nil
+						}
+					}
+					return diag.Errorf("%s can only be set to %s", ScopeVar, strings.Join(helper.MemberRoleScopes, ", "))
+				},
+			},
+			RolesVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Roles which can be given to members of the scope",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		ReadContext: read,
+	}
+}
@@ -0,0 +1,48 @@
+package member_roles_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/member_roles"
+)
+
+func TestAccMemberRolesDatasource_Scope(t *testing.T) {
+	datasourceName := "zitadel_member_roles"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	scope := test_utils.AttributeValue(t, member_roles.ScopeVar, attributes).AsString()
+	// the output block is cut off, because it references the example data source
+	config = strings.Join(strings.Split(config, "\n")[0:3], "\n")
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		nil,
+		checkContainsRole(frame, "ORG_OWNER"),
+		map[string]string{
+			"id":    scope,
+			"scope": scope,
+		},
+	)
+}
+
+func checkContainsRole(frame *test_utils.OrgTestFrame, expect string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		datasource, ok := state.RootModule().Resources["data."+frame.TerraformName]
+		if !ok {
+			return fmt.Errorf("data source %s not found in state", frame.TerraformName)
+		}
+		for key, role := range datasource.Primary.Attributes {
+			if strings.HasPrefix(key, member_roles.RolesVar+".") && role == expect {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected role %s in %s", expect, member_roles.RolesVar)
+	}
+}
@@ -0,0 +1,28 @@
+package member_roles
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	scope := d.Get(ScopeVar).(string)
+	roles, err := helper.ListMemberRoles(ctx, clientinfo, scope)
+	if err != nil {
+		return diag.Errorf("failed to list %s member roles: %v", scope, err)
+	}
+	d.SetId(scope)
+	return diag.FromErr(d.Set(RolesVar, roles))
+}
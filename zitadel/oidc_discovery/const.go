@@ -0,0 +1,26 @@
+package oidc_discovery
+
+const (
+	DocumentVar                         = "document"
+	IssuerVar                           = "issuer"
+	authorizationEndpointVar            = "authorization_endpoint"
+	tokenEndpointVar                    = "token_endpoint"
+	userinfoEndpointVar                 = "userinfo_endpoint"
+	introspectionEndpointVar            = "introspection_endpoint"
+	revocationEndpointVar               = "revocation_endpoint"
+	endSessionEndpointVar               = "end_session_endpoint"
+	deviceAuthorizationEndpointVar      = "device_authorization_endpoint"
+	JWKSURIVar                          = "jwks_uri"
+	scopesSupportedVar                  = "scopes_supported"
+	responseTypesSupportedVar           = "response_types_supported"
+	grantTypesSupportedVar              = "grant_types_supported"
+	idTokenSigningAlgValuesSupportedVar = "id_token_signing_alg_values_supported"
+	claimsSupportedVar                  = "claims_supported"
+	JWKSVar                             = "jwks"
+	KeysVar                             = "keys"
+	keyIDVar                            = "kid"
+	keyTypeVar                          = "kty"
+	algorithmVar                        = "alg"
+	useVar                              = "use"
+	discoveryPath                       = "/.well-known/openid-configuration"
+)
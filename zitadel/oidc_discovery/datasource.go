@@ -0,0 +1,59 @@
+package oidc_discovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func GetDatasource() *schema.Resource {
+	computedString := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: description,
+		}
+	}
+	computedStrings := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: description,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		}
+	}
+	return &schema.Resource{
+		Description: "Datasource representing the OIDC discovery document and the JSON web key set of the instance, which are fetched from the configured domain. " +
+			"Use it to configure relying parties which trust ZITADEL.",
+		Schema: map[string]*schema.Schema{
+			DocumentVar:                         computedString("Raw discovery document as JSON"),
+			IssuerVar:                           computedString("Issuer of the tokens"),
+			authorizationEndpointVar:            computedString("URL of the authorization endpoint"),
+			tokenEndpointVar:                    computedString("URL of the token endpoint"),
+			userinfoEndpointVar:                 computedString("URL of the userinfo endpoint"),
+			introspectionEndpointVar:            computedString("URL of the introspection endpoint"),
+			revocationEndpointVar:               computedString("URL of the revocation endpoint"),
+			endSessionEndpointVar:               computedString("URL of the end session endpoint"),
+			deviceAuthorizationEndpointVar:      computedString("URL of the device authorization endpoint"),
+			JWKSURIVar:                          computedString("URL of the JSON web key set"),
+			scopesSupportedVar:                  computedStrings("Supported scopes"),
+			responseTypesSupportedVar:           computedStrings("Supported response types"),
+			grantTypesSupportedVar:              computedStrings("Supported grant types"),
+			idTokenSigningAlgValuesSupportedVar: computedStrings("Supported algorithms for signing ID tokens"),
+			claimsSupportedVar:                  computedStrings("Supported claims"),
+			JWKSVar:                             computedString("Raw JSON web key set, which contains the public keys to verify tokens"),
+			KeysVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Public keys of the JSON web key set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						keyIDVar:     computedString("ID of the key"),
+						keyTypeVar:   computedString("Type of the key"),
+						algorithmVar: computedString("Algorithm of the key"),
+						useVar:       computedString("Use of the key"),
+					},
+				},
+			},
+		},
+		ReadContext: read,
+	}
+}
@@ -0,0 +1,80 @@
+package oidc_discovery_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/oidc_discovery"
+)
+
+const jwks = `{"keys":[{"use":"sig","kty":"RSA","kid":"123456789012345678","alg":"RS256","n":"AQAB","e":"AQAB"}]}`
+
+func TestOIDCDiscoveryDatasource(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	discovery := fmt.Sprintf(`{
+  "issuer": "%[1]s",
+  "authorization_endpoint": "%[1]s/oauth/v2/authorize",
+  "token_endpoint": "%[1]s/oauth/v2/token",
+  "userinfo_endpoint": "%[1]s/oidc/v1/userinfo",
+  "end_session_endpoint": "%[1]s/oidc/v1/end_session",
+  "jwks_uri": "%[1]s/oauth/v2/keys",
+  "scopes_supported": ["openid", "profile", "email"],
+  "grant_types_supported": ["authorization_code", "refresh_token"]
+}`, server.URL)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(discovery))
+	})
+	mux.HandleFunc("/oauth/v2/keys", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(jwks))
+	})
+
+	datasource := oidc_discovery.GetDatasource()
+	d := schema.TestResourceDataRaw(t, datasource.Schema, map[string]interface{}{})
+	if diags := datasource.ReadContext(context.Background(), d, &helper.ClientInfo{Issuer: server.URL}); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	for k, want := range map[string]string{
+		"id":                      server.URL + "/.well-known/openid-configuration",
+		"document":                discovery,
+		"issuer":                  server.URL,
+		"token_endpoint":          server.URL + "/oauth/v2/token",
+		"end_session_endpoint":    server.URL + "/oidc/v1/end_session",
+		"introspection_endpoint":  "",
+		"jwks_uri":                server.URL + "/oauth/v2/keys",
+		"scopes_supported.#":      "3",
+		"scopes_supported.0":      "openid",
+		"grant_types_supported.1": "refresh_token",
+		"jwks":                    jwks,
+		"keys.#":                  "1",
+		"keys.0.kid":              "123456789012345678",
+		"keys.0.alg":              "RS256",
+		"keys.0.use":              "sig",
+	} {
+		if got := d.State().Attributes[k]; got != want {
+			t.Errorf("expected %s to be %q, but got %q", k, want, got)
+		}
+	}
+}
+
+func TestOIDCDiscoveryDatasource_InvalidDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("not json"))
+	}))
+	defer server.Close()
+
+	datasource := oidc_discovery.GetDatasource()
+	d := schema.TestResourceDataRaw(t, datasource.Schema, map[string]interface{}{})
+	if diags := datasource.ReadContext(context.Background(), d, &helper.ClientInfo{Issuer: server.URL}); !diags.HasError() {
+		t.Fatal("expected read to fail")
+	}
+}
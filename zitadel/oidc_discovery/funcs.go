@@ -0,0 +1,103 @@
+package oidc_discovery
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+type discovery struct {
+	Issuer                           string   `json:"issuer"`
+	AuthorizationEndpoint            string   `json:"authorization_endpoint"`
+	TokenEndpoint                    string   `json:"token_endpoint"`
+	UserinfoEndpoint                 string   `json:"userinfo_endpoint"`
+	IntrospectionEndpoint            string   `json:"introspection_endpoint"`
+	RevocationEndpoint               string   `json:"revocation_endpoint"`
+	EndSessionEndpoint               string   `json:"end_session_endpoint"`
+	DeviceAuthorizationEndpoint      string   `json:"device_authorization_endpoint"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	ScopesSupported                  []string `json:"scopes_supported"`
+	ResponseTypesSupported           []string `json:"response_types_supported"`
+	GrantTypesSupported              []string `json:"grant_types_supported"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	ClaimsSupported                  []string `json:"claims_supported"`
+}
+
+type jsonWebKeySet struct {
+	Keys []struct {
+		KeyID     string `json:"kid"`
+		KeyType   string `json:"kty"`
+		Algorithm string `json:"alg"`
+		Use       string `json:"use"`
+	} `json:"keys"`
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	document, err := helper.GetFromIssuer(ctx, clientinfo, discoveryPath)
+	if err != nil {
+		return diag.Errorf("failed to get oidc discovery document: %v", err)
+	}
+	config := new(discovery)
+	if err := json.Unmarshal(document, config); err != nil {
+		return diag.Errorf("failed to parse oidc discovery document: %v", err)
+	}
+	set := map[string]interface{}{
+		DocumentVar:                         string(document),
+		IssuerVar:                           config.Issuer,
+		authorizationEndpointVar:            config.AuthorizationEndpoint,
+		tokenEndpointVar:                    config.TokenEndpoint,
+		userinfoEndpointVar:                 config.UserinfoEndpoint,
+		introspectionEndpointVar:            config.IntrospectionEndpoint,
+		revocationEndpointVar:               config.RevocationEndpoint,
+		endSessionEndpointVar:               config.EndSessionEndpoint,
+		deviceAuthorizationEndpointVar:      config.DeviceAuthorizationEndpoint,
+		JWKSURIVar:                          config.JWKSURI,
+		scopesSupportedVar:                  config.ScopesSupported,
+		responseTypesSupportedVar:           config.ResponseTypesSupported,
+		grantTypesSupportedVar:              config.GrantTypesSupported,
+		idTokenSigningAlgValuesSupportedVar: config.IDTokenSigningAlgValuesSupported,
+		claimsSupportedVar:                  config.ClaimsSupported,
+		JWKSVar:                             "",
+		KeysVar:                             []interface{}{},
+	}
+	if config.JWKSURI != "" {
+		jwks, err := helper.GetURL(ctx, config.JWKSURI)
+		if err != nil {
+			return diag.Errorf("failed to get json web key set: %v", err)
+		}
+		keySet := new(jsonWebKeySet)
+		if err := json.Unmarshal(jwks, keySet); err != nil {
+			return diag.Errorf("failed to parse json web key set: %v", err)
+		}
+		keys := make([]interface{}, len(keySet.Keys))
+		for i, key := range keySet.Keys {
+			keys[i] = map[string]interface{}{
+				keyIDVar:     key.KeyID,
+				keyTypeVar:   key.KeyType,
+				algorithmVar: key.Algorithm,
+				useVar:       key.Use,
+			}
+		}
+		set[JWKSVar] = string(jwks)
+		set[KeysVar] = keys
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of oidc discovery: %v", k, err)
+		}
+	}
+	d.SetId(clientinfo.Issuer + discoveryPath)
+	return nil
+}
@@ -6,7 +6,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	adminclient "github.com/zitadel/zitadel-go/v3/pkg/client/admin"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/change"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/org"
@@ -20,6 +23,19 @@ func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	if !ok {
 		return diag.Errorf("failed to get client")
 	}
+	if helper.DeactivateOnDestroy(d) {
+		client, err := helper.GetManagementClient(ctx, clientinfo)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = client.DeactivateOrg(helper.CtxSetOrgID(ctx, d.Id()), &management.DeactivateOrgRequest{})
+		// An org which is already inactive can't be deactivated again
+		if err := helper.IgnorePreconditionError(err); err != nil {
+			return diag.Errorf("failed to deactivate org: %v", err)
+		}
+		d.SetId("")
+		return nil
+	}
 	client, err := helper.GetAdminClient(ctx, clientinfo)
 	if err != nil {
 		return diag.FromErr(err)
@@ -44,6 +60,23 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if helper.DeactivateOnDestroy(d) {
+		adminClient, err := helper.GetAdminClient(ctx, clientinfo)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		orgId, err := reactivateDeactivatedOrg(ctx, clientinfo, adminClient, client, d.Get(NameVar).(string))
+		if err != nil {
+			return diag.Errorf("failed to adopt deactivated org: %v", err)
+		}
+		if orgId != "" {
+			d.SetId(orgId)
+			if diags := update(ctx, d, m); diags.HasError() {
+				return diags
+			}
+			return get(ctx, d, m)
+		}
+	}
 	resp, err := client.AddOrg(ctx, &management.AddOrgRequest{
 		Name: d.Get(NameVar).(string),
 	})
@@ -67,6 +100,44 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	return nil
 }
 
+// reactivateDeactivatedOrg reactivates a deactivated org with the given name, which was deactivated on destroy by the provider, and returns its ID.
+// If no such org exists, an empty string is returned.
+func reactivateDeactivatedOrg(ctx context.Context, clientinfo *helper.ClientInfo, adminClient *adminclient.Client, client *mgmt.Client, name string) (string, error) {
+	resp, err := adminClient.ListOrgs(ctx, &admin.ListOrgsRequest{
+		Queries: []*org.OrgQuery{{
+			Query: &org.OrgQuery_NameQuery{
+				NameQuery: &org.OrgNameQuery{
+					Name:   name,
+					Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, o := range resp.GetResult() {
+		if o.GetState() != org.OrgState_ORG_STATE_INACTIVE {
+			continue
+		}
+		orgCtx := helper.CtxSetOrgID(ctx, o.GetId())
+		adopted, err := helper.AdoptDeactivated(orgCtx, clientinfo, func(query *change.ChangeQuery) ([]*change.Change, error) {
+			resp, err := client.ListOrgChanges(orgCtx, &management.ListOrgChangesRequest{Query: query})
+			return resp.GetResult(), err
+		}, func() error {
+			_, err := client.ReactivateOrg(orgCtx, &management.ReactivateOrgRequest{})
+			return err
+		})
+		if err != nil {
+			return "", err
+		}
+		if adopted {
+			return o.GetId(), nil
+		}
+	}
+	return "", nil
+}
+
 func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started update")
 	clientinfo, ok := m.(*helper.ClientInfo)
@@ -83,7 +154,7 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		_, err = client.UpdateOrg(helper.CtxSetOrgID(ctx, d.Id()), &management.UpdateOrgRequest{
 			Name: d.Get(NameVar).(string),
 		})
-		if err != nil {
+		if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 			return diag.Errorf("failed to update org: %v", err)
 		}
 	}
@@ -10,6 +10,7 @@ func GetResource() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource representing an organization in ZITADEL, which is the highest level after the instance and contains several other resource including policies if the configuration differs to the default policies on the instance.",
 		Schema: map[string]*schema.Schema{
+			helper.OnDestroyVar: helper.OnDestroyResourceField,
 			NameVar: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -137,3 +137,8 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 func getOrgMemberID(org string, userID string) string {
 	return org + "_" + userID
 }
+
+// customizeDiff validates the roles against the organization member roles at plan time
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return helper.ValidateMemberRoles(ctx, d, m, helper.MemberRoleScopeOrg, RolesVar)
+}
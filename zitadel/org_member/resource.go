@@ -23,13 +23,14 @@ func GetResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 				Required:    true,
-				Description: "List of roles granted",
+				Description: "List of roles granted, the roles are validated at plan time against zitadel_member_roles with scope org",
 			},
 		},
 		DeleteContext: delete,
 		CreateContext: create,
 		UpdateContext: update,
 		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
 		Importer: helper.ImportWithEmptyID(
 			helper.NewImportAttribute(UserIDVar, helper.ConvertID, false),
 			helper.ImportOptionalOrgAttribute,
@@ -3,6 +3,7 @@ package org_member_test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -40,6 +41,24 @@ func TestAccOrgMember(t *testing.T) {
 	)
 }
 
+func TestAccOrgMemberUnknownRole(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_org_member")
+	userDep, _ := human_user_test_dep.Create(t, frame)
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, org_member.RolesVar, exampleAttributes).AsValueSlice()[0].AsString()
+	test_utils.RunStepsTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, userDep},
+		nil,
+		resource.TestStep{
+			Config:      strings.Replace(resourceExample, exampleProperty, "ORG_OWNR", 1),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`role "ORG_OWNR", which is not a valid org member role`),
+		},
+	)
+}
+
 func checkRemoteProperty(frame test_utils.OrgTestFrame, userID string) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
@@ -1,6 +1,8 @@
 package org_metadata
 
 const (
-	KeyVar   = "key"
-	ValueVar = "value"
+	KeyVar         = "key"
+	ValueVar       = "value"
+	ValueBase64Var = "value_base64"
+	ValueJSONVar   = "value_json"
 )
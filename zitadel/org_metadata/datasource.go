@@ -0,0 +1,25 @@
+package org_metadata
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing a metadata entry of an organization. The value is exposed as string, base64 encoded and as normalized JSON document.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDDatasourceField,
+			KeyVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The key of a metadata entry",
+			},
+			ValueVar:       helper.MetadataValueDatasourceField,
+			ValueBase64Var: helper.MetadataValueBase64DatasourceField,
+			ValueJSONVar:   helper.MetadataValueJSONDatasourceField,
+		},
+		ReadContext: readFunc(true),
+	}
+}
@@ -0,0 +1,38 @@
+package org_metadata_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_metadata"
+)
+
+func TestAccOrgMetadataDatasource(t *testing.T) {
+	datasourceName := "zitadel_org_metadata"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	key := test_utils.AttributeValue(t, org_metadata.KeyVar, attributes).AsString()
+	// the value is no JSON document, so value_json stays empty
+	value := "a_value"
+	if _, err := frame.SetOrgMetadata(frame, &management.SetOrgMetadataRequest{
+		Key:   key,
+		Value: []byte(value),
+	}); err != nil {
+		t.Fatalf("failed to set metadata: %v", err)
+	}
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency},
+		nil,
+		map[string]string{
+			"value":        value,
+			"value_base64": base64.StdEncoding.EncodeToString([]byte(value)),
+			"value_json":   "",
+		},
+	)
+}
@@ -21,7 +21,10 @@ func set(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagno
 	}
 
 	key := d.Get(KeyVar).(string)
-	value := []byte(d.Get(ValueVar).(string))
+	value, err := helper.GetMetadataValue(d)
+	if err != nil {
+		return diag.Errorf("failed to get metadata value: %v", err)
+	}
 	_, err = client.SetOrgMetadata(helper.CtxWithOrgID(ctx, d), &management.SetOrgMetadataRequest{
 		Key:   key,
 		Value: value,
@@ -33,36 +36,36 @@ func set(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagno
 	return nil
 }
 
-func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	clientinfo, ok := m.(*helper.ClientInfo)
-	if !ok {
-		return diag.Errorf("failed to get client")
-	}
-	client, err := helper.GetManagementClient(ctx, clientinfo)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	key := helper.GetID(d, KeyVar)
-	resp, err := client.GetOrgMetadata(helper.CtxWithOrgID(ctx, d), &management.GetOrgMetadataRequest{Key: key})
-	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
-		d.SetId("")
-		return nil
-	}
-	if err != nil {
-		return diag.Errorf("failed to get metadata object")
-	}
-	value := string(resp.GetMetadata().GetValue())
-	set := map[string]interface{}{
-		KeyVar:   key,
-		ValueVar: value,
-	}
-	for k, v := range set {
-		if err := d.Set(k, v); err != nil {
-			return diag.Errorf("failed to set metadata with key %s: %v", k, err)
+// readFunc sets the value in the configured representation for resources and in all representations for data sources
+func readFunc(forDatasource bool) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		clientinfo, ok := m.(*helper.ClientInfo)
+		if !ok {
+			return diag.Errorf("failed to get client")
+		}
+		client, err := helper.GetManagementClient(ctx, clientinfo)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		key := helper.GetID(d, KeyVar)
+		resp, err := client.GetOrgMetadata(helper.CtxWithOrgID(ctx, d), &management.GetOrgMetadataRequest{Key: key})
+		if err != nil && helper.IgnoreIfNotFoundError(err) == nil && !forDatasource {
+			d.SetId("")
+			return nil
 		}
+		if err != nil {
+			return diag.Errorf("failed to get metadata object")
+		}
+		set := helper.MetadataValueState(d, resp.GetMetadata().GetValue(), forDatasource)
+		set[KeyVar] = key
+		for k, v := range set {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("failed to set metadata with key %s: %v", k, err)
+			}
+		}
+		d.SetId(key)
+		return nil
 	}
-	d.SetId(key)
-	return nil
 }
 
 func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
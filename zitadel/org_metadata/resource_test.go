@@ -3,6 +3,7 @@ package org_metadata_test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -16,6 +17,8 @@ import (
 func TestAccOrgMetadata(t *testing.T) {
 	frame := test_utils.NewOrgTestFrame(t, "zitadel_org_metadata")
 	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	// the example also shows binary and JSON values, so we cut it down to the first block
+	resourceExample = strings.Join(strings.Split(resourceExample, "\n")[0:5], "\n")
 	keyProperty := test_utils.AttributeValue(t, org_metadata.KeyVar, exampleAttributes).AsString()
 	exampleProperty := test_utils.AttributeValue(t, org_metadata.ValueVar, exampleAttributes).AsString()
 	updatedProperty := "another_value"
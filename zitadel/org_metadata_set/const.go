@@ -0,0 +1,6 @@
+package org_metadata_set
+
+const (
+	MetadataVar  = "metadata"
+	ExclusiveVar = "exclusive"
+)
@@ -0,0 +1,153 @@
+package org_metadata_set
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/metadata"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func set(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started set")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	orgCtx := helper.CtxWithOrgID(ctx, d)
+	remote, err := helper.ListAllMetadata(func(query *object.ListQuery) ([]*metadata.Metadata, error) {
+		resp, err := client.ListOrgMetadata(orgCtx, &management.ListOrgMetadataRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return diag.Errorf("failed to list metadata entries: %v", err)
+	}
+	previous, desired := d.GetChange(MetadataVar)
+	toSet, toRemove := helper.MetadataChanges(helper.MetadataMap(desired), remote, helper.MetadataMap(previous), d.Get(ExclusiveVar).(bool))
+	if len(toRemove) > 0 {
+		_, err = client.BulkRemoveOrgMetadata(orgCtx, &management.BulkRemoveOrgMetadataRequest{Keys: toRemove})
+		if err != nil {
+			return diag.Errorf("failed to remove metadata entries: %v", err)
+		}
+	}
+	if len(toSet) > 0 {
+		entries := make([]*management.BulkSetOrgMetadataRequest_Metadata, 0, len(toSet))
+		for _, key := range helper.SortedMetadataKeys(toSet) {
+			entries = append(entries, &management.BulkSetOrgMetadataRequest_Metadata{Key: key, Value: []byte(toSet[key])})
+		}
+		_, err = client.BulkSetOrgMetadata(orgCtx, &management.BulkSetOrgMetadataRequest{Metadata: entries})
+		if err != nil {
+			return diag.Errorf("failed to set metadata entries: %v", err)
+		}
+	}
+	if d.Id() == "" {
+		// without an org_id, the metadata belongs to the organization of the authenticated user
+		resp, err := client.GetMyOrg(orgCtx, &management.GetMyOrgRequest{})
+		if err != nil {
+			return diag.Errorf("failed to get organization: %v", err)
+		}
+		d.SetId(resp.GetOrg().GetId())
+	}
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	orgID := helper.GetID(d, helper.OrgIDVar)
+	remote, err := helper.ListAllMetadata(func(query *object.ListQuery) ([]*metadata.Metadata, error) {
+		resp, err := client.ListOrgMetadata(helper.CtxSetOrgID(ctx, orgID), &management.ListOrgMetadataRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to list metadata entries: %v", err)
+	}
+	set := map[string]interface{}{
+		helper.OrgIDVar: orgID,
+		MetadataVar:     helper.TrackedMetadata(remote, helper.MetadataMap(d.Get(MetadataVar)), d.Get(ExclusiveVar).(bool)),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of metadata set: %v", k, err)
+		}
+	}
+	d.SetId(orgID)
+	return nil
+}
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := helper.SortedMetadataKeys(helper.MetadataMap(d.Get(MetadataVar)))
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err = client.BulkRemoveOrgMetadata(helper.CtxWithID(ctx, d), &management.BulkRemoveOrgMetadataRequest{Keys: keys})
+	if err := helper.IgnoreIfNotFoundError(err); err != nil {
+		return diag.Errorf("failed to remove metadata entries: %v", err)
+	}
+	return nil
+}
+
+// importState tracks all remote metadata entries in the state, as the imported resource manages the entries which exist at the time of the import
+func importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	states, err := helper.ImportWithOptionalOrg().StateContext(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return nil, fmt.Errorf("failed to get client")
+	}
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := helper.ListAllMetadata(func(query *object.ListQuery) ([]*metadata.Metadata, error) {
+		resp, err := client.ListOrgMetadata(helper.CtxSetOrgID(ctx, helper.GetID(d, helper.OrgIDVar)), &management.ListOrgMetadataRequest{Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list metadata entries: %w", err)
+	}
+	if err := d.Set(MetadataVar, helper.TrackedMetadata(remote, nil, true)); err != nil {
+		return nil, fmt.Errorf("failed to set %s of metadata set: %w", MetadataVar, err)
+	}
+	return states, nil
+}
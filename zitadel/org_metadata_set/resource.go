@@ -0,0 +1,32 @@
+package org_metadata_set
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages multiple custom attributes of an organization at once. You can use this information in your actions. In contrast to the zitadel_org_metadata resource, this Terraform resource manages all given key-value pairs with a single resource.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDResourceField,
+			MetadataVar: {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Description: "The metadata entries as a map of keys to the string representations of their values.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			ExclusiveVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, metadata entries of the organization which are not in the metadata map are removed. Otherwise, only the entries in the metadata map are managed. Defaults to false.",
+			},
+		},
+		CreateContext: set,
+		DeleteContext: delete,
+		ReadContext:   read,
+		UpdateContext: set,
+		Importer:      &schema.ResourceImporter{StateContext: importState},
+	}
+}
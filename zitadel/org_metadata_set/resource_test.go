@@ -0,0 +1,57 @@
+package org_metadata_set_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_metadata_set"
+)
+
+const exampleKey = "a_key"
+
+func TestAccOrgMetadataSet(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_org_metadata_set")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, org_metadata_set.MetadataVar, exampleAttributes).AsValueMap()[exampleKey].AsString()
+	updatedProperty := "updated_value"
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame),
+		regexp.MustCompile(fmt.Sprintf(`^%s$`, frame.OrgID)),
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(*frame), ""),
+		test_utils.ImportOrgId(frame),
+	)
+}
+
+func checkRemoteProperty(frame test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.ListOrgMetadata(frame, &management.ListOrgMetadataRequest{})
+			if err != nil {
+				return err
+			}
+			for _, entry := range resp.GetResult() {
+				if entry.GetKey() != exampleKey {
+					continue
+				}
+				if actual := string(entry.GetValue()); actual != expect {
+					return fmt.Errorf("expected value %s, but got %s", expect, actual)
+				}
+				return nil
+			}
+			return fmt.Errorf("metadata entry %s: %w", exampleKey, test_utils.ErrNotFound)
+		}
+	}
+}
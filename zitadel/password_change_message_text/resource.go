@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &passwordChangeMessageTextResource{}
+	_ resource.Resource               = &passwordChangeMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &passwordChangeMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -87,6 +88,10 @@ func (r *passwordChangeMessageTextResource) Configure(_ context.Context, req res
 	r.clientInfo = clientInfo
 }
 
+func (r *passwordChangeMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *passwordChangeMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -383,4 +388,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &passwordResetMessageTextResource{}
+	_ resource.Resource               = &passwordResetMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &passwordResetMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -87,6 +88,10 @@ func (r *passwordResetMessageTextResource) Configure(_ context.Context, req reso
 	r.clientInfo = clientInfo
 }
 
+func (r *passwordResetMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *passwordResetMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -383,4 +388,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
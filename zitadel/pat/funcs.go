@@ -2,13 +2,13 @@ package pat
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
@@ -52,13 +52,11 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	req := &management.AddPersonalAccessTokenRequest{
 		UserId: d.Get(UserIDVar).(string),
 	}
-	if expiration, ok := d.GetOk(ExpirationDateVar); ok {
-		t, err := time.Parse(time.RFC3339, expiration.(string))
-		if err != nil {
-			return diag.Errorf("failed to parse time: %v", err)
-		}
-		req.ExpirationDate = timestamppb.New(t)
+	expirationDate, err := helper.GetExpirationDate(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	req.ExpirationDate = expirationDate
 
 	resp, err := client.AddPersonalAccessToken(helper.CtxWithOrgID(ctx, d), req)
 	if err != nil {
@@ -69,6 +67,12 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 	d.SetId(resp.GetTokenId())
+	// The expiration date is needed in the state to plan the rotation, also if it is computed from the lifetime
+	if expirationDate != nil {
+		if err := d.Set(ExpirationDateVar, expirationDate.AsTime().Format(time.RFC3339)); err != nil {
+			return diag.Errorf("failed to set %s of pat: %v", ExpirationDateVar, err)
+		}
+	}
 	return nil
 }
 
@@ -99,9 +103,10 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	}
 
 	set := map[string]interface{}{
-		ExpirationDateVar: resp.GetToken().GetExpirationDate().AsTime().Format(time.RFC3339),
-		UserIDVar:         userID,
-		helper.OrgIDVar:   orgID,
+		ExpirationDateVar:   resp.GetToken().GetExpirationDate().AsTime().Format(time.RFC3339),
+		helper.ExpiresInVar: helper.ExpiresIn(resp.GetToken().GetExpirationDate().AsTime()),
+		UserIDVar:           userID,
+		helper.OrgIDVar:     orgID,
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
@@ -109,5 +114,25 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 		}
 	}
 	d.SetId(resp.GetToken().GetId())
-	return nil
+	return helper.ExpiryWarning(d, "personal access token", resp.GetToken().GetExpirationDate().AsTime())
+}
+
+// customizeDiff plans the replacement of the personal access token if it is due for rotation
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return helper.CustomizeDiffRotateBefore(ctx, d, m, creationDate, TokenVar)
+}
+
+func creationDate(ctx context.Context, d *schema.ResourceDiff, clientinfo *helper.ClientInfo) (time.Time, error) {
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return time.Time{}, err
+	}
+	resp, err := client.GetPersonalAccessTokenByIDs(helper.CtxSetOrgID(ctx, d.Get(helper.OrgIDVar).(string)), &management.GetPersonalAccessTokenByIDsRequest{
+		UserId:  d.Get(UserIDVar).(string),
+		TokenId: d.Id(),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get pat: %v", err)
+	}
+	return resp.GetToken().GetDetails().GetCreationDate().AsTime(), nil
 }
@@ -24,15 +24,23 @@ func GetResource() *schema.Resource {
 				Sensitive:   true,
 			},
 			ExpirationDateVar: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Expiration date of the token in the RFC3339 format",
-				ForceNew:    true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Expiration date of the token in the RFC3339 format",
+				ForceNew:      true,
+				ConflictsWith: []string{helper.LifetimeVar},
 			},
+			helper.LifetimeVar:     helper.LifetimeResourceField,
+			helper.RotateBeforeVar: helper.RotateBeforeResourceField,
+			helper.ExpiresInVar:    helper.ExpiresInResourceField,
 		},
 		DeleteContext: delete,
 		CreateContext: create,
 		ReadContext:   read,
+		// lifetime and rotate_before only affect future replacements, so an update only refreshes the state
+		UpdateContext: read,
+		CustomizeDiff: customizeDiff,
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			tokenIDVar,
 			helper.NewImportAttribute(UserIDVar, helper.ConvertID, false),
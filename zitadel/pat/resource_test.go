@@ -2,7 +2,9 @@ package pat_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -40,6 +42,47 @@ func TestAccPersonalAccessToken(t *testing.T) {
 	)
 }
 
+func TestAccPersonalAccessTokenRotateBefore(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_personal_access_token")
+	userDep, _ := machine_user_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	// the rotate_before window starts a minute after the creation of the token
+	expiration := time.Now().Add(5 * time.Minute).UTC().Truncate(time.Second)
+	windowStart := expiration.Add(-4 * time.Minute)
+	config := strings.Replace(resourceExample, test_utils.AttributeValue(t, pat.ExpirationDateVar, exampleAttributes).AsString(), expiration.Format(time.RFC3339), 1)
+	config = strings.Replace(config, test_utils.AttributeValue(t, helper.RotateBeforeVar, exampleAttributes).AsString(), "4m", 1)
+	var id string
+	test_utils.RunStepsTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, userDep},
+		nil,
+		resource.TestStep{ // Check the token is created outside the window
+			Config: config,
+			Check: func(state *terraform.State) error {
+				id = frame.State(state).ID
+				return nil
+			},
+		},
+		resource.TestStep{ // Check the token is replaced inside the window and the replacement isn't replaced again
+			PreConfig: func() {
+				time.Sleep(time.Until(windowStart))
+			},
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				func(state *terraform.State) error {
+					if replaced := frame.State(state).ID; replaced == id {
+						return fmt.Errorf("expected the token %s to be replaced", id)
+					}
+					return nil
+				},
+				resource.TestCheckResourceAttr(frame.TerraformName, pat.ExpirationDateVar, expiration.Format(time.RFC3339)),
+				resource.TestCheckResourceAttrSet(frame.TerraformName, helper.ExpiresInVar),
+			),
+		},
+	)
+}
+
 func checkRemoteProperty(frame test_utils.OrgTestFrame, userID string) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/change"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/project"
@@ -26,9 +28,17 @@ func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	_, err = client.RemoveProject(helper.CtxWithOrgID(ctx, d), &management.RemoveProjectRequest{
-		Id: d.Id(),
-	})
+	if helper.DeactivateOnDestroy(d) {
+		_, err = client.DeactivateProject(helper.CtxWithOrgID(ctx, d), &management.DeactivateProjectRequest{
+			Id: d.Id(),
+		})
+		// A project which is already inactive can't be deactivated again
+		err = helper.IgnorePreconditionError(err)
+	} else {
+		_, err = client.RemoveProject(helper.CtxWithOrgID(ctx, d), &management.RemoveProjectRequest{
+			Id: d.Id(),
+		})
+	}
 	if err != nil {
 		return diag.Errorf("failed to delete project: %v", err)
 	}
@@ -56,7 +66,7 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		HasProjectCheck:        d.Get(hasProjectCheckVar).(bool),
 		PrivateLabelingSetting: project.PrivateLabelingSetting(project.PrivateLabelingSetting_value[d.Get(privateLabelingSettingVar).(string)]),
 	})
-	if err != nil {
+	if err := helper.IgnoreUnchangedOnAdopt(d, err); err != nil {
 		return diag.Errorf("failed to update project: %v", err)
 	}
 
@@ -76,6 +86,20 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
+	if helper.DeactivateOnDestroy(d) {
+		projectID, err := reactivateDeactivatedProject(helper.CtxWithOrgID(ctx, d), clientinfo, client, d.Get(NameVar).(string))
+		if err != nil {
+			return diag.Errorf("failed to adopt deactivated project: %v", err)
+		}
+		if projectID != "" {
+			d.SetId(projectID)
+			if diags := update(ctx, d, m); diags.HasError() {
+				return diags
+			}
+			return read(ctx, d, m)
+		}
+	}
+
 	plSetting := d.Get(privateLabelingSettingVar).(string)
 	resp, err := client.AddProject(helper.CtxWithOrgID(ctx, d), &management.AddProjectRequest{
 		Name:                   d.Get(NameVar).(string),
@@ -91,6 +115,43 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 	return nil
 }
 
+// reactivateDeactivatedProject reactivates a deactivated project with the given name, which was deactivated on destroy by the provider, and returns its ID.
+// If no such project exists, an empty string is returned.
+func reactivateDeactivatedProject(ctx context.Context, clientinfo *helper.ClientInfo, client *mgmt.Client, name string) (string, error) {
+	resp, err := client.ListProjects(ctx, &management.ListProjectsRequest{
+		Queries: []*project.ProjectQuery{{
+			Query: &project.ProjectQuery_NameQuery{
+				NameQuery: &project.ProjectNameQuery{
+					Name:   name,
+					Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, p := range resp.GetResult() {
+		if p.GetState() != project.ProjectState_PROJECT_STATE_INACTIVE {
+			continue
+		}
+		adopted, err := helper.AdoptDeactivated(ctx, clientinfo, func(query *change.ChangeQuery) ([]*change.Change, error) {
+			resp, err := client.ListProjectChanges(ctx, &management.ListProjectChangesRequest{Query: query, ProjectId: p.GetId()})
+			return resp.GetResult(), err
+		}, func() error {
+			_, err := client.ReactivateProject(ctx, &management.ReactivateProjectRequest{Id: p.GetId()})
+			return err
+		})
+		if err != nil {
+			return "", err
+		}
+		if adopted {
+			return p.GetId(), nil
+		}
+	}
+	return "", nil
+}
+
 func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started read")
 
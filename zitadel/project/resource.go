@@ -13,7 +13,8 @@ func GetResource() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource representing the project, which can then be granted to different organizations or users directly, containing different applications.",
 		Schema: map[string]*schema.Schema{
-			helper.OrgIDVar: helper.OrgIDResourceField,
+			helper.OrgIDVar:     helper.OrgIDResourceField,
+			helper.OnDestroyVar: helper.OnDestroyResourceField,
 			NameVar: {
 				Type:        schema.TypeString,
 				Required:    true,
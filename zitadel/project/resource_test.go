@@ -2,11 +2,13 @@ package project_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	projectpb "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/project"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
@@ -36,6 +38,30 @@ func TestAccProject(t *testing.T) {
 	)
 }
 
+func TestAccProjectOnDestroyDeactivate(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_project")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleName := test_utils.AttributeValue(t, project.NameVar, exampleAttributes).AsString()
+	resourceExample = test_utils.WithOnDestroyDeactivate(strings.Replace(resourceExample, exampleName, frame.UniqueResourcesID, 1))
+	exampleRoleAssertion := "project_role_assertion   = true"
+	test_utils.RunOnDestroyDeactivateTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency},
+		resourceExample, strings.Replace(resourceExample, exampleRoleAssertion, "project_role_assertion   = false", 1),
+		func(id string, active bool) error {
+			remoteResource, err := frame.GetProjectByID(frame, &management.GetProjectByIDRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			if actual := remoteResource.GetProject().GetState() == projectpb.ProjectState_PROJECT_STATE_ACTIVE; actual != active {
+				return fmt.Errorf("expected project to be active %t, but got state %s", active, remoteResource.GetProject().GetState())
+			}
+			return nil
+		},
+	)
+}
+
 func checkRemoteProperty(frame *test_utils.OrgTestFrame) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
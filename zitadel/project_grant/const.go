@@ -1,7 +1,12 @@
 package project_grant
 
 const (
-	ProjectIDVar    = "project_id"
-	grantedOrgIDVar = "granted_org_id"
-	RoleKeysVar     = "role_keys"
+	ProjectIDVar      = "project_id"
+	grantedOrgIDVar   = "granted_org_id"
+	RoleKeysVar       = "role_keys"
+	ProjectGrantsVar  = "project_grants"
+	grantIDVar        = "id"
+	grantedOrgNameVar = "granted_org_name"
+	stateVar          = "state"
+	listPageSize      = 1000
 )
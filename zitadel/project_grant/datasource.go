@@ -0,0 +1,70 @@
+package project_grant
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func ListDatasources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing the grants of a project to other organizations, optionally filtered by the granted organization and role keys.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDDatasourceField,
+			ProjectIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the project",
+			},
+			grantedOrgIDVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the organization granted the project",
+			},
+			RoleKeysVar: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Role keys which all have to be granted",
+			},
+			ProjectGrantsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Project grants matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						grantIDVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the project grant",
+						},
+						grantedOrgIDVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the organization granted the project",
+						},
+						grantedOrgNameVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the organization granted the project",
+						},
+						RoleKeysVar: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of roles granted",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						stateVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the project grant",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: list,
+	}
+}
@@ -0,0 +1,70 @@
+package project_grant_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project/project_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_grant"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_role/project_role_test_dep"
+)
+
+func TestAccProjectGrantsDatasource_GrantedOrg_RoleKeys(t *testing.T) {
+	datasourceName := "zitadel_project_grants"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	exampleGrantedOrgID := test_utils.AttributeValue(t, "granted_org_id", attributes).AsString()
+	roleKey := test_utils.AttributeValue(t, project_grant.RoleKeysVar, attributes).AsValueSlice()[0].AsString()
+	// the output block is cut off, because it references the example data source
+	config = strings.Join(strings.Split(config, "\n")[0:6], "\n")
+	projectDep, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	project_role_test_dep.Create(t, frame, projectID, roleKey, "other")
+	grantedOrgID := frame.AnotherOrg(t, "project-grants-"+frame.UniqueResourcesID).OrgID
+	otherOrgID := frame.AnotherOrg(t, "project-grants-other-"+frame.UniqueResourcesID).OrgID
+	grantIDs := make(map[string]string)
+	for orgID, key := range map[string]string{grantedOrgID: roleKey, otherOrgID: "other"} {
+		resp, err := frame.AddProjectGrant(frame, &management.AddProjectGrantRequest{
+			ProjectId:    projectID,
+			GrantedOrgId: orgID,
+			RoleKeys:     []string{key},
+		})
+		if err != nil {
+			t.Fatalf("failed to add project grant: %v", err)
+		}
+		grantIDs[orgID] = resp.GetGrantId()
+	}
+	config = strings.Replace(config, exampleGrantedOrgID, grantedOrgID, 1)
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency, projectDep},
+		checkRemoteGrantsCount(*frame, projectID, 2),
+		map[string]string{
+			"project_grants.#":                "1",
+			"project_grants.0.id":             grantIDs[grantedOrgID],
+			"project_grants.0.granted_org_id": grantedOrgID,
+			"project_grants.0.role_keys.#":    "1",
+			"project_grants.0.role_keys.0":    roleKey,
+		},
+	)
+}
+
+func checkRemoteGrantsCount(frame test_utils.OrgTestFrame, projectID string, expect int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resp, err := frame.ListProjectGrants(frame, &management.ListProjectGrantsRequest{ProjectId: projectID})
+		if err != nil {
+			return err
+		}
+		if actual := len(resp.GetResult()); actual != expect {
+			return fmt.Errorf("expected %d project grants, but got %d", expect, actual)
+		}
+		return nil
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/project"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
@@ -120,3 +122,66 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	d.SetId(projectGrant.GetGrantId())
 	return nil
 }
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get(ProjectIDVar).(string)
+	var queries []*project.ProjectGrantQuery
+	for _, roleKey := range helper.GetOkSetToStringSlice(d, RoleKeysVar) {
+		queries = append(queries, &project.ProjectGrantQuery{
+			Query: &project.ProjectGrantQuery_RoleKeyQuery{
+				RoleKeyQuery: &project.GrantRoleKeyQuery{
+					RoleKey: roleKey,
+					Method:  object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+				},
+			},
+		})
+	}
+	// the API doesn't support querying the grants of a project by the granted organization
+	grantedOrgID := d.Get(grantedOrgIDVar).(string)
+	grants := make([]interface{}, 0)
+	for offset := uint64(0); ; offset += listPageSize {
+		resp, err := client.ListProjectGrants(helper.CtxWithOrgID(ctx, d), &management.ListProjectGrantsRequest{
+			ProjectId: projectID,
+			Query:     &object.ListQuery{Offset: offset, Limit: listPageSize},
+			Queries:   queries,
+		})
+		if err != nil {
+			return diag.Errorf("failed to list projectgrants: %v", err)
+		}
+		for _, grant := range resp.GetResult() {
+			if grantedOrgID != "" && grant.GetGrantedOrgId() != grantedOrgID {
+				continue
+			}
+			grants = append(grants, map[string]interface{}{
+				grantIDVar:        grant.GetGrantId(),
+				grantedOrgIDVar:   grant.GetGrantedOrgId(),
+				grantedOrgNameVar: grant.GetGrantedOrgName(),
+				RoleKeysVar:       grant.GetGrantedRoleKeys(),
+				stateVar:          grant.GetState().String(),
+			})
+		}
+		if len(resp.GetResult()) < listPageSize {
+			break
+		}
+	}
+	// the datasource is identified by the project whose grants it lists
+	d.SetId(projectID)
+	return diag.FromErr(d.Set(ProjectGrantsVar, grants))
+}
+
+// customizeDiff validates the role keys against the roles of the project at plan time
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return helper.ValidateRoleKeys(ctx, d, m, ProjectIDVar, "", RoleKeysVar)
+}
@@ -29,13 +29,14 @@ func GetResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 				Optional:    true,
-				Description: "List of roles granted",
+				Description: "List of roles granted, the roles are validated against the project at plan time, unless roles of the project are added or changed in the same run. Unknown roles are reported with the attribute name and the role key. Reference added roles, for example with zitadel_project_role.default.role_key, so they are created before the grant",
 			},
 		},
 		DeleteContext: delete,
 		CreateContext: create,
 		UpdateContext: update,
 		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
 		Importer: helper.ImportWithIDAndOptionalOrg(
 			"",
 			helper.NewImportAttribute(ProjectIDVar, helper.ConvertID, false),
@@ -2,6 +2,8 @@ package project_grant_test
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -43,6 +45,26 @@ func TestAccProjectGrant(t *testing.T) {
 	)
 }
 
+func TestAccProjectGrantUnknownRoleKey(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_project_grant")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, project_grant.RoleKeysVar, exampleAttributes).AsValueSlice()[0].AsString()
+	projectDep, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	project_role_test_dep.Create(t, frame, projectID, exampleProperty)
+	grantedOrgDep, _, _ := org_test_dep.Create(t, frame, "granted_org")
+	test_utils.RunStepsTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, projectDep, grantedOrgDep},
+		nil,
+		resource.TestStep{
+			Config:      strings.Replace(resourceExample, exampleProperty, "unknown-role", 1),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`role "unknown-role", which doesn't exist in project`),
+		},
+	)
+}
+
 func checkRemoteProperty(frame test_utils.OrgTestFrame, projectID string) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
@@ -151,3 +151,8 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 func getProjectGrantMemberID(org, projectID, grantID, userID string) string {
 	return org + "_" + projectID + "_" + grantID + "_" + userID
 }
+
+// customizeDiff validates the roles against the project grant member roles at plan time
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return helper.ValidateMemberRoles(ctx, d, m, helper.MemberRoleScopeProjectGrant, RolesVar)
+}
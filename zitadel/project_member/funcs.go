@@ -144,3 +144,8 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 func getProjectMemberID(org string, projectID string, userID string) string {
 	return org + "_" + projectID + "_" + userID
 }
+
+// customizeDiff validates the roles against the project member roles at plan time
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return helper.ValidateMemberRoles(ctx, d, m, helper.MemberRoleScopeProject, rolesVar)
+}
@@ -149,3 +149,11 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 func getProjectRoleID(orgID string, projectID string, roleKey string) string {
 	return orgID + "_" + projectID + "_" + roleKey
 }
+
+// customizeDiff registers added roles, so grants of the project are validated by the API on apply instead of at plan time
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown(ProjectIDVar) && (d.Id() == "" || d.HasChanges(ProjectIDVar, KeyVar)) {
+		helper.PlanRoleChanges(m, d.Get(ProjectIDVar).(string))
+	}
+	return nil
+}
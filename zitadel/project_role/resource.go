@@ -38,6 +38,7 @@ func GetResource() *schema.Resource {
 		CreateContext: create,
 		UpdateContext: update,
 		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
 		Importer: helper.ImportWithEmptyID(
 			helper.NewImportAttribute(ProjectIDVar, helper.ConvertID, false),
 			helper.NewImportAttribute(KeyVar, helper.ConvertNonEmpty, false),
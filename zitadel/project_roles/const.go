@@ -0,0 +1,13 @@
+package project_roles
+
+const (
+	ProjectIDVar         = "project_id"
+	RolesVar             = "roles"
+	KeyVar               = "key"
+	DisplayNameVar       = "display_name"
+	GroupVar             = "group"
+	keyMethodVar         = "key_method"
+	displayNameMethodVar = "display_name_method"
+)
+
+const listPageSize = 1000
@@ -0,0 +1,82 @@
+package project_roles
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing the roles of a project, optionally filtered by key, display name and group.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDDatasourceField,
+			ProjectIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the project",
+			},
+			KeyVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of the project roles",
+			},
+			keyMethodVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Method for querying project roles by key" + helper.DescriptionEnumValuesList(object.TextQueryMethod_name),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return helper.EnumValueValidation(keyMethodVar, value, object.TextQueryMethod_value)
+				},
+				Default: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS.String(),
+			},
+			DisplayNameVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Display name of the project roles",
+			},
+			displayNameMethodVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Method for querying project roles by display name" + helper.DescriptionEnumValuesList(object.TextQueryMethod_name),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return helper.EnumValueValidation(displayNameMethodVar, value, object.TextQueryMethod_value)
+				},
+				Default: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS.String(),
+			},
+			GroupVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Exact group of the project roles",
+			},
+			RolesVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Project roles matching the filters, ordered by key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						KeyVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key used for project role",
+						},
+						DisplayNameVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name used for project role",
+						},
+						GroupVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Group used for project role",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: list,
+	}
+}
@@ -0,0 +1,61 @@
+package project_roles_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project/project_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_roles"
+)
+
+func TestAccProjectRolesDatasource_Group(t *testing.T) {
+	datasourceName := "zitadel_project_roles"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	group := test_utils.AttributeValue(t, project_roles.GroupVar, attributes).AsString()
+	// the output block is cut off, because it references the example data source
+	config = strings.Join(strings.Split(config, "\n")[0:5], "\n")
+	projectDep, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	for key, roleGroup := range map[string]string{"grouped": group, "ungrouped": ""} {
+		if _, err := frame.AddProjectRole(frame, &management.AddProjectRoleRequest{
+			ProjectId:   projectID,
+			RoleKey:     key,
+			DisplayName: key,
+			Group:       roleGroup,
+		}); err != nil {
+			t.Fatalf("failed to add project role %s: %v", key, err)
+		}
+	}
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency, projectDep},
+		checkRemoteRolesCount(*frame, projectID, 2),
+		map[string]string{
+			"roles.#":              "1",
+			"roles.0.key":          "grouped",
+			"roles.0.display_name": "grouped",
+			"roles.0.group":        group,
+		},
+	)
+}
+
+func checkRemoteRolesCount(frame test_utils.OrgTestFrame, projectID string, expect int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resp, err := frame.ListProjectRoles(frame, &management.ListProjectRolesRequest{ProjectId: projectID})
+		if err != nil {
+			return err
+		}
+		if actual := len(resp.GetResult()); actual != expect {
+			return fmt.Errorf("expected %d roles, but got %d", expect, actual)
+		}
+		return nil
+	}
+}
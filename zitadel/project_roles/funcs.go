@@ -0,0 +1,310 @@
+package project_roles
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	mgmt "github.com/zitadel/zitadel-go/v3/pkg/client/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/project"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := helper.GetID(d, ProjectIDVar)
+	for _, key := range sortedKeys(rolesMap(d.Get(RolesVar))) {
+		_, err = client.RemoveProjectRole(helper.CtxWithOrgID(ctx, d), &management.RemoveProjectRoleRequest{
+			ProjectId: projectID,
+			RoleKey:   key,
+		})
+		if err != nil && helper.IgnoreIfNotFoundError(err) != nil {
+			return diag.Errorf("failed to delete project role %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func set(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started set")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get(ProjectIDVar).(string)
+	remote, err := listRoles(ctx, client, d, projectID)
+	if err != nil {
+		return diag.Errorf("failed to list project roles: %v", err)
+	}
+	oldRoles, newRoles := d.GetChange(RolesVar)
+	previous := rolesMap(oldRoles)
+	desired := rolesMap(newRoles)
+	// roles which are neither managed by this resource nor desired are not touched
+	current := make([]string, 0)
+	for key := range remote {
+		_, managed := previous[key]
+		_, wanted := desired[key]
+		if managed || wanted {
+			current = append(current, key)
+		}
+	}
+	sort.Strings(current)
+	add, remove := helper.GetAddAndDelete(current, sortedKeys(desired))
+
+	if len(add) > 0 {
+		roles := make([]*management.BulkAddProjectRolesRequest_Role, len(add))
+		for i, key := range add {
+			roles[i] = &management.BulkAddProjectRolesRequest_Role{
+				Key:         key,
+				DisplayName: desired[key].GetDisplayName(),
+				Group:       desired[key].GetGroup(),
+			}
+		}
+		_, err = client.BulkAddProjectRoles(helper.CtxWithOrgID(ctx, d), &management.BulkAddProjectRolesRequest{
+			ProjectId: projectID,
+			Roles:     roles,
+		})
+		if err != nil {
+			return diag.Errorf("failed to add project roles: %v", err)
+		}
+	}
+	for _, key := range current {
+		role, ok := desired[key]
+		if !ok || role.GetDisplayName() == remote[key].GetDisplayName() && role.GetGroup() == remote[key].GetGroup() {
+			continue
+		}
+		_, err = client.UpdateProjectRole(helper.CtxWithOrgID(ctx, d), &management.UpdateProjectRoleRequest{
+			ProjectId:   projectID,
+			RoleKey:     key,
+			DisplayName: role.GetDisplayName(),
+			Group:       role.GetGroup(),
+		})
+		if err != nil {
+			return diag.Errorf("failed to update project role %s: %v", key, err)
+		}
+	}
+	for _, key := range remove {
+		_, err = client.RemoveProjectRole(helper.CtxWithOrgID(ctx, d), &management.RemoveProjectRoleRequest{
+			ProjectId: projectID,
+			RoleKey:   key,
+		})
+		if err != nil && helper.IgnoreIfNotFoundError(err) != nil {
+			return diag.Errorf("failed to remove project role %s: %v", key, err)
+		}
+	}
+	d.SetId(projectID)
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := helper.GetID(d, ProjectIDVar)
+	remote, err := listRoles(ctx, client, d, projectID)
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to list project roles: %v", err)
+	}
+	previous := rolesMap(d.Get(RolesVar))
+	tracked := make(map[string]*project.Role)
+	for key, role := range remote {
+		if _, managed := previous[key]; managed {
+			tracked[key] = role
+		}
+	}
+	set := map[string]interface{}{
+		ProjectIDVar: projectID,
+		RolesVar:     rolesState(tracked),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of project roles: %v", k, err)
+		}
+	}
+	d.SetId(projectID)
+	return nil
+}
+
+// importState tracks all roles of the project in the state, as the imported resource manages the roles which exist at the time of the import
+func importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	states, err := helper.ImportWithIDAndOptionalOrg(ProjectIDVar).StateContext(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return nil, fmt.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := listRoles(ctx, client, d, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project roles: %w", err)
+	}
+	if err := d.Set(RolesVar, rolesState(remote)); err != nil {
+		return nil, fmt.Errorf("failed to set %s of project roles: %w", RolesVar, err)
+	}
+	return states, nil
+}
+
+// listRoles pages through the roles of the project which match the queries and maps the keys to the roles
+func listRoles(ctx context.Context, client *mgmt.Client, d *schema.ResourceData, projectID string, queries ...*project.RoleQuery) (map[string]*project.Role, error) {
+	roles := make(map[string]*project.Role)
+	for offset := uint64(0); ; offset += listPageSize {
+		resp, err := client.ListProjectRoles(helper.CtxWithOrgID(ctx, d), &management.ListProjectRolesRequest{
+			ProjectId: projectID,
+			Query:     &object.ListQuery{Offset: offset, Limit: listPageSize},
+			Queries:   queries,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range resp.GetResult() {
+			roles[role.GetKey()] = role
+		}
+		if len(resp.GetResult()) < listPageSize {
+			return roles, nil
+		}
+	}
+}
+
+// customizeDiff registers added or changed roles, so grants of the project are validated by the API on apply instead of at plan time
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown(ProjectIDVar) && (d.Id() == "" || d.HasChanges(ProjectIDVar, RolesVar)) {
+		helper.PlanRoleChanges(m, d.Get(ProjectIDVar).(string))
+	}
+	return nil
+}
+
+// rolesMap converts the roles set to roles mapped by their keys
+func rolesMap(raw interface{}) map[string]*project.Role {
+	roles := make(map[string]*project.Role)
+	if raw == nil {
+		return roles
+	}
+	for _, item := range raw.(*schema.Set).List() {
+		role := item.(map[string]interface{})
+		key := role[KeyVar].(string)
+		roles[key] = &project.Role{
+			Key:         key,
+			DisplayName: role[DisplayNameVar].(string),
+			Group:       role[GroupVar].(string),
+		}
+	}
+	return roles
+}
+
+func rolesState(roles map[string]*project.Role) []interface{} {
+	state := make([]interface{}, 0, len(roles))
+	for _, key := range sortedKeys(roles) {
+		state = append(state, map[string]interface{}{
+			KeyVar:         key,
+			DisplayNameVar: roles[key].GetDisplayName(),
+			GroupVar:       roles[key].GetGroup(),
+		})
+	}
+	return state
+}
+
+func sortedKeys(roles map[string]*project.Role) []string {
+	keys := make([]string, 0, len(roles))
+	for key := range roles {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get(ProjectIDVar).(string)
+	var queries []*project.RoleQuery
+	if key := d.Get(KeyVar).(string); key != "" {
+		queries = append(queries, &project.RoleQuery{
+			Query: &project.RoleQuery_KeyQuery{
+				KeyQuery: &project.RoleKeyQuery{
+					Key:    key,
+					Method: object.TextQueryMethod(object.TextQueryMethod_value[d.Get(keyMethodVar).(string)]),
+				},
+			},
+		})
+	}
+	if displayName := d.Get(DisplayNameVar).(string); displayName != "" {
+		queries = append(queries, &project.RoleQuery{
+			Query: &project.RoleQuery_DisplayNameQuery{
+				DisplayNameQuery: &project.RoleDisplayNameQuery{
+					DisplayName: displayName,
+					Method:      object.TextQueryMethod(object.TextQueryMethod_value[d.Get(displayNameMethodVar).(string)]),
+				},
+			},
+		})
+	}
+	roles, err := listRoles(ctx, client, d, projectID, queries...)
+	if err != nil {
+		return diag.Errorf("failed to list project roles: %v", err)
+	}
+	// the API doesn't support querying roles by group
+	group := d.Get(GroupVar).(string)
+	matching := make(map[string]*project.Role)
+	for key, role := range roles {
+		if group == "" || role.GetGroup() == group {
+			matching[key] = role
+		}
+	}
+	// If the ID is blank, the datasource is deleted and not usable.
+	d.SetId(projectID)
+	return diag.FromErr(d.Set(RolesVar, rolesState(matching)))
+}
@@ -0,0 +1,54 @@
+package project_roles
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing multiple roles of a project, which are added in bulk. " +
+			"Roles of the project which are not part of the resource, for example managed by zitadel_project_role resources, are not touched. " +
+			"After an import, all roles of the project are managed by the resource.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDResourceField,
+			ProjectIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the project",
+				ForceNew:    true,
+			},
+			RolesVar: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Roles of the project",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						KeyVar: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key used for project role",
+						},
+						DisplayNameVar: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name used for project role",
+						},
+						GroupVar: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Group used for project role",
+						},
+					},
+				},
+			},
+		},
+		DeleteContext: delete,
+		CreateContext: set,
+		UpdateContext: set,
+		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
+		Importer:      &schema.ResourceImporter{StateContext: importState},
+	}
+}
@@ -0,0 +1,72 @@
+package project_roles_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/project"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project/project_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_roles"
+)
+
+const exampleRoleKey = "super-user"
+
+func TestAccProjectRoles(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_project_roles")
+	resourceExample, _ := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := "Super User"
+	updatedProperty := "Updated Super User"
+	projectDep, projectID := project_test_dep.Create(t, frame, frame.UniqueResourcesID)
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, projectDep},
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame, projectID),
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(*frame, projectID), ""),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, project_roles.ProjectIDVar),
+			test_utils.ImportOrgId(frame),
+		),
+	)
+}
+
+func checkRemoteProperty(frame test_utils.OrgTestFrame, projectID string) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.ListProjectRoles(frame, &management.ListProjectRolesRequest{
+				ProjectId: projectID,
+				Queries: []*project.RoleQuery{{
+					Query: &project.RoleQuery_KeyQuery{
+						KeyQuery: &project.RoleKeyQuery{
+							Key:    exampleRoleKey,
+							Method: object.TextQueryMethod_TEXT_QUERY_METHOD_EQUALS,
+						},
+					},
+				}},
+			})
+			if err != nil {
+				return err
+			}
+			actualRoles := resp.GetResult()
+			if len(actualRoles) == 0 {
+				return test_utils.ErrNotFound
+			}
+			if actual := actualRoles[0].GetDisplayName(); actual != expect {
+				return fmt.Errorf("expected role display name %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_domain_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_init_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_label_policy"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_language"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_lockout_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_login_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/default_login_texts"
@@ -39,8 +40,13 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/domain"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/domain_claimed_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/domain_policy"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/domain_verification"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/email_provider"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/email_provider_http"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/granted_projects"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/human_user"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/human_users_import"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_azure_ad"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_github"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_github_es"
@@ -52,14 +58,21 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_oidc"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_saml"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/init_message_text"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_domain"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_limits"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_member"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_quota"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/instance_restrictions"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/label_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/lockout_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/login_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/login_texts"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/machine_key"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/machine_user"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/member_roles"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/notification_policy"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/oidc_discovery"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_idp_azure_ad"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_idp_github"
@@ -74,6 +87,7 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_idp_saml"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_member"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_metadata"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/org_metadata_set"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/password_age_policy"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/password_change_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/password_complexity_policy"
@@ -86,12 +100,17 @@ import (
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_grant_member"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_member"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_role"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/project_roles"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/saml_idp_metadata"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/secret_generator"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/sms_provider_http"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/sms_provider_twilio"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/smtp_config"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/trigger_actions"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/user_grant"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/user_idp_link"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/user_metadata"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/user_metadata_set"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/verify_email_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/verify_email_otp_message_text"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/verify_phone_message_text"
@@ -113,13 +132,16 @@ func NewProviderPV6(option ...zitadel_go.Option) provider.Provider {
 
 // providerModel represents the provider's configuration schema
 type providerModel struct {
-	Insecure       types.Bool   `tfsdk:"insecure"`
-	Domain         types.String `tfsdk:"domain"`
-	Port           types.String `tfsdk:"port"`
-	Token          types.String `tfsdk:"token"`
-	JWTFile        types.String `tfsdk:"jwt_file"`
-	JWTProfileFile types.String `tfsdk:"jwt_profile_file"`
-	JWTProfileJSON types.String `tfsdk:"jwt_profile_json"`
+	Insecure         types.Bool   `tfsdk:"insecure"`
+	Domain           types.String `tfsdk:"domain"`
+	Port             types.String `tfsdk:"port"`
+	Token            types.String `tfsdk:"token"`
+	JWTFile          types.String `tfsdk:"jwt_file"`
+	JWTProfileFile   types.String `tfsdk:"jwt_profile_file"`
+	JWTProfileJSON   types.String `tfsdk:"jwt_profile_json"`
+	SystemAPIUser    types.String `tfsdk:"system_api_user"`
+	SystemAPIKey     types.String `tfsdk:"system_api_key"`
+	SystemAPIKeyFile types.String `tfsdk:"system_api_key_file"`
 }
 
 // Metadata returns the provider type name
@@ -159,6 +181,19 @@ func (p *providerPV6) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Optional:    true,
 				Description: helper.PortDescription,
 			},
+			helper.SystemAPIUserVar: schema.StringAttribute{
+				Optional:    true,
+				Description: helper.SystemAPIUserDescription,
+			},
+			helper.SystemAPIKeyVar: schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: helper.SystemAPIKeyDescription,
+			},
+			helper.SystemAPIKeyFileVar: schema.StringAttribute{
+				Optional:    true,
+				Description: helper.SystemAPIKeyFileDescription,
+			},
 		},
 	}
 }
@@ -181,6 +216,9 @@ func (p *providerPV6) Configure(ctx context.Context, req provider.ConfigureReque
 		config.JWTProfileFile.ValueString(),
 		config.JWTProfileJSON.ValueString(),
 		config.Port.ValueString(),
+		config.SystemAPIUser.ValueString(),
+		config.SystemAPIKeyFile.ValueString(),
+		config.SystemAPIKey.ValueString(),
 	)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to handle provider config", err.Error())
@@ -231,11 +269,16 @@ func Provider() *sdkschema.Provider {
 			"zitadel_org":                        org.GetDatasource(),
 			"zitadel_orgs":                       org.ListDatasources(),
 			"zitadel_human_user":                 human_user.GetDatasource(),
+			"zitadel_human_users":                human_user.ListDatasources(),
 			"zitadel_machine_user":               machine_user.GetDatasource(),
 			"zitadel_machine_users":              machine_user.ListDatasources(),
 			"zitadel_project":                    project.GetDatasource(),
 			"zitadel_projects":                   project.ListDatasources(),
 			"zitadel_project_role":               project_role.GetDatasource(),
+			"zitadel_project_roles":              project_roles.GetDatasource(),
+			"zitadel_project_grants":             project_grant.ListDatasources(),
+			"zitadel_granted_projects":           granted_projects.GetDatasource(),
+			"zitadel_member_roles":               member_roles.GetDatasource(),
 			"zitadel_action":                     action.GetDatasource(),
 			"zitadel_application_oidc":           application_oidc.GetDatasource(),
 			"zitadel_application_oidcs":          application_oidc.ListDatasources(),
@@ -266,6 +309,14 @@ func Provider() *sdkschema.Provider {
 			"zitadel_org_idp_saml":               org_idp_saml.GetDatasource(),
 			"zitadel_org_idp_oauth":              org_idp_oauth.GetDatasource(),
 			"zitadel_default_oidc_settings":      default_oidc_settings.GetDatasource(),
+			"zitadel_oidc_discovery":             oidc_discovery.GetDatasource(),
+			"zitadel_saml_idp_metadata":          saml_idp_metadata.GetDatasource(),
+			"zitadel_secret_generators":          secret_generator.ListDatasources(),
+			"zitadel_email_providers":            email_provider.ListDatasources(),
+			"zitadel_instance":                   instance.GetDatasource(),
+			"zitadel_instances":                  instance.ListDatasources(),
+			"zitadel_user_metadata":              user_metadata.GetDatasource(),
+			"zitadel_org_metadata":               org_metadata.GetDatasource(),
 		},
 		Schema: map[string]*sdkschema.Schema{
 			helper.DomainVar: {
@@ -303,14 +354,33 @@ func Provider() *sdkschema.Provider {
 				Optional:    true,
 				Description: helper.PortDescription,
 			},
+			helper.SystemAPIUserVar: {
+				Type:        sdkschema.TypeString,
+				Optional:    true,
+				Description: helper.SystemAPIUserDescription,
+			},
+			helper.SystemAPIKeyVar: {
+				Type:        sdkschema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: helper.SystemAPIKeyDescription,
+			},
+			helper.SystemAPIKeyFileVar: {
+				Type:        sdkschema.TypeString,
+				Optional:    true,
+				Description: helper.SystemAPIKeyFileDescription,
+			},
 		},
 		ResourcesMap: map[string]*sdkschema.Resource{
 			"zitadel_org":                                org.GetResource(),
 			"zitadel_human_user":                         human_user.GetResource(),
+			"zitadel_human_users_import":                 human_users_import.GetResource(),
 			"zitadel_machine_user":                       machine_user.GetResource(),
 			"zitadel_project":                            project.GetResource(),
 			"zitadel_project_role":                       project_role.GetResource(),
+			"zitadel_project_roles":                      project_roles.GetResource(),
 			"zitadel_domain":                             domain.GetResource(),
+			"zitadel_domain_verification":                domain_verification.GetResource(),
 			"zitadel_action":                             action.GetResource(),
 			"zitadel_application_oidc":                   application_oidc.GetResource(),
 			"zitadel_application_api":                    application_api.GetResource(),
@@ -342,6 +412,7 @@ func Provider() *sdkschema.Provider {
 			"zitadel_sms_provider_twilio":                sms_provider_twilio.GetResource(),
 			"zitadel_sms_provider_http":                  sms_provider_http.GetResource(),
 			"zitadel_smtp_config":                        smtp_config.GetResource(),
+			"zitadel_email_provider_http":                email_provider_http.GetResource(),
 			"zitadel_default_notification_policy":        default_notification_policy.GetResource(),
 			"zitadel_notification_policy":                notification_policy.GetResource(),
 			"zitadel_idp_github":                         idp_github.GetResource(),
@@ -368,6 +439,16 @@ func Provider() *sdkschema.Provider {
 			"zitadel_default_oidc_settings":              default_oidc_settings.GetResource(),
 			"zitadel_org_metadata":                       org_metadata.GetResource(),
 			"zitadel_user_metadata":                      user_metadata.GetResource(),
+			"zitadel_org_metadata_set":                   org_metadata_set.GetResource(),
+			"zitadel_user_metadata_set":                  user_metadata_set.GetResource(),
+			"zitadel_user_idp_link":                      user_idp_link.GetResource(),
+			"zitadel_instance_restrictions":              instance_restrictions.GetResource(),
+			"zitadel_default_language":                   default_language.GetResource(),
+			"zitadel_secret_generator":                   secret_generator.GetResource(),
+			"zitadel_instance":                           instance.GetResource(),
+			"zitadel_instance_domain":                    instance_domain.GetResource(),
+			"zitadel_instance_quota":                     instance_quota.GetResource(),
+			"zitadel_instance_limits":                    instance_limits.GetResource(),
 		},
 		ConfigureContextFunc: ProviderConfigure,
 	}
@@ -383,10 +464,13 @@ func ProviderConfigure(ctx context.Context, d *sdkschema.ResourceData) (interfac
 		d.Get(helper.JWTProfileFileVar).(string),
 		d.Get(helper.JWTProfileJSONVar).(string),
 		d.Get(helper.PortVar).(string),
+		d.Get(helper.SystemAPIUserVar).(string),
+		d.Get(helper.SystemAPIKeyFileVar).(string),
+		d.Get(helper.SystemAPIKeyVar).(string),
 	)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 
 	return clientinfo, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,13 @@
+package saml_idp_metadata
+
+const (
+	MetadataXMLVar          = "metadata_xml"
+	EntityIDVar             = "entity_id"
+	singleSignOnServicesVar = "single_sign_on_services"
+	singleLogoutServicesVar = "single_logout_services"
+	bindingVar              = "binding"
+	locationVar             = "location"
+	SigningCertificatesVar  = "signing_certificates"
+	nameIDFormatsVar        = "name_id_formats"
+	metadataPath            = "/saml/v2/metadata"
+)
@@ -0,0 +1,66 @@
+package saml_idp_metadata
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func GetDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing the SAML identity provider metadata of the instance, which is fetched from the configured domain. " +
+			"Use it to configure service providers which trust ZITADEL.",
+		Schema: map[string]*schema.Schema{
+			MetadataXMLVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Raw metadata document as XML",
+			},
+			EntityIDVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Entity ID of the identity provider",
+			},
+			singleSignOnServicesVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Single sign-on endpoints of the identity provider",
+				Elem:        serviceResource(),
+			},
+			singleLogoutServicesVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Single logout endpoints of the identity provider",
+				Elem:        serviceResource(),
+			},
+			SigningCertificatesVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Base64 encoded X.509 certificates which sign the SAML responses",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			nameIDFormatsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Supported name ID formats",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		ReadContext: read,
+	}
+}
+
+func serviceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			bindingVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SAML binding of the endpoint",
+			},
+			locationVar: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the endpoint",
+			},
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package saml_idp_metadata_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/saml_idp_metadata"
+)
+
+const metadata = `<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://zitadel.example.com/saml/v2/metadata">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo>
+        <ds:X509Data>
+          <ds:X509Certificate>
+            MIIBsigning
+            certificate
+          </ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:KeyDescriptor use="encryption">
+      <ds:KeyInfo>
+        <ds:X509Data>
+          <ds:X509Certificate>MIIBencryption</ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:2.0:nameid-format:persistent</md:NameIDFormat>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://zitadel.example.com/saml/v2/SSO"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://zitadel.example.com/saml/v2/SSO"/>
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://zitadel.example.com/saml/v2/SLO"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+
+func TestSAMLIDPMetadataDatasource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/saml/v2/metadata", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.Write([]byte(metadata))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	datasource := saml_idp_metadata.GetDatasource()
+	d := schema.TestResourceDataRaw(t, datasource.Schema, map[string]interface{}{})
+	if diags := datasource.ReadContext(context.Background(), d, &helper.ClientInfo{Issuer: server.URL}); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	for k, want := range map[string]string{
+		"id":                                 server.URL + "/saml/v2/metadata",
+		"metadata_xml":                       metadata,
+		"entity_id":                          "https://zitadel.example.com/saml/v2/metadata",
+		"signing_certificates.#":             "1",
+		"signing_certificates.0":             "MIIBsigningcertificate",
+		"single_sign_on_services.#":          "2",
+		"single_sign_on_services.1.binding":  "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+		"single_sign_on_services.1.location": "https://zitadel.example.com/saml/v2/SSO",
+		"single_logout_services.#":           "1",
+		"single_logout_services.0.location":  "https://zitadel.example.com/saml/v2/SLO",
+		"name_id_formats.0":                  "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+	} {
+		if got := d.State().Attributes[k]; got != want {
+			t.Errorf("expected %s to be %q, but got %q", k, want, got)
+		}
+	}
+}
+
+func TestSAMLIDPMetadataDatasource_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	datasource := saml_idp_metadata.GetDatasource()
+	d := schema.TestResourceDataRaw(t, datasource.Schema, map[string]interface{}{})
+	if diags := datasource.ReadContext(context.Background(), d, &helper.ClientInfo{Issuer: server.URL}); !diags.HasError() {
+		t.Fatal("expected read to fail")
+	}
+}
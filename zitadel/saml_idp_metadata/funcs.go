@@ -0,0 +1,87 @@
+package saml_idp_metadata
+
+import (
+	"context"
+	"encoding/xml"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+type idpMetadata struct {
+	EntityID         string `xml:"entityID,attr"`
+	IDPSSODescriptor struct {
+		KeyDescriptors []struct {
+			Use         string `xml:"use,attr"`
+			Certificate string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"KeyDescriptor"`
+		SingleSignOnServices []service `xml:"SingleSignOnService"`
+		SingleLogoutServices []service `xml:"SingleLogoutService"`
+		NameIDFormats        []string  `xml:"NameIDFormat"`
+	} `xml:"IDPSSODescriptor"`
+}
+
+type service struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	document, err := helper.GetFromIssuer(ctx, clientinfo, metadataPath)
+	if err != nil {
+		return diag.Errorf("failed to get saml idp metadata: %v", err)
+	}
+	metadata := new(idpMetadata)
+	if err := xml.Unmarshal(document, metadata); err != nil {
+		return diag.Errorf("failed to parse saml idp metadata: %v", err)
+	}
+	// keys without use are used for signing and encryption
+	certificates := make([]string, 0)
+	for _, key := range metadata.IDPSSODescriptor.KeyDescriptors {
+		certificate := strings.Join(strings.Fields(key.Certificate), "")
+		if certificate != "" && (key.Use == "" || key.Use == "signing") {
+			certificates = append(certificates, certificate)
+		}
+	}
+	nameIDFormats := make([]string, 0, len(metadata.IDPSSODescriptor.NameIDFormats))
+	for _, format := range metadata.IDPSSODescriptor.NameIDFormats {
+		nameIDFormats = append(nameIDFormats, strings.TrimSpace(format))
+	}
+	set := map[string]interface{}{
+		MetadataXMLVar:          string(document),
+		EntityIDVar:             metadata.EntityID,
+		singleSignOnServicesVar: servicesState(metadata.IDPSSODescriptor.SingleSignOnServices),
+		singleLogoutServicesVar: servicesState(metadata.IDPSSODescriptor.SingleLogoutServices),
+		SigningCertificatesVar:  certificates,
+		nameIDFormatsVar:        nameIDFormats,
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of saml idp metadata: %v", k, err)
+		}
+	}
+	d.SetId(clientinfo.Issuer + metadataPath)
+	return nil
+}
+
+func servicesState(services []service) []interface{} {
+	state := make([]interface{}, len(services))
+	for i, s := range services {
+		state[i] = map[string]interface{}{
+			bindingVar:  s.Binding,
+			locationVar: s.Location,
+		}
+	}
+	return state
+}
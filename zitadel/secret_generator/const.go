@@ -0,0 +1,12 @@
+package secret_generator
+
+const (
+	GeneratorTypeVar       = "generator_type"
+	LengthVar              = "length"
+	ExpiryVar              = "expiry"
+	includeLowerLettersVar = "include_lower_letters"
+	includeUpperLettersVar = "include_upper_letters"
+	includeDigitsVar       = "include_digits"
+	includeSymbolsVar      = "include_symbols"
+	secretGeneratorsVar    = "secret_generators"
+)
@@ -0,0 +1,61 @@
+package secret_generator
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func ListDatasources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Datasource representing the configurations of all secret generators of an instance.",
+		Schema: map[string]*schema.Schema{
+			secretGeneratorsVar: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Configurations of all secret generators",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						GeneratorTypeVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the secret generator" + helper.DescriptionEnumValuesList(settings.SecretGeneratorType_name),
+						},
+						LengthVar: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Length of the generated secret",
+						},
+						ExpiryVar: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiry duration of the generated secret",
+						},
+						includeLowerLettersVar: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Include lower case letters in the generated secret",
+						},
+						includeUpperLettersVar: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Include upper case letters in the generated secret",
+						},
+						includeDigitsVar: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Include digits in the generated secret",
+						},
+						includeSymbolsVar: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Include symbols in the generated secret",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: list,
+	}
+}
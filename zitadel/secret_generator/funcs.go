@@ -0,0 +1,181 @@
+package secret_generator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Warn(ctx, "secret generator configuration cannot be deleted")
+	return nil
+}
+
+func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started update")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	generatorType := d.Get(GeneratorTypeVar).(string)
+	expiry, err := time.ParseDuration(d.Get(ExpiryVar).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.UpdateSecretGenerator(ctx, &admin.UpdateSecretGeneratorRequest{
+		GeneratorType:       settings.SecretGeneratorType(settings.SecretGeneratorType_value[generatorType]),
+		Length:              uint32(d.Get(LengthVar).(int)),
+		Expiry:              durationpb.New(expiry),
+		IncludeLowerLetters: d.Get(includeLowerLettersVar).(bool),
+		IncludeUpperLetters: d.Get(includeUpperLettersVar).(bool),
+		IncludeDigits:       d.Get(includeDigitsVar).(bool),
+		IncludeSymbols:      d.Get(includeSymbolsVar).(bool),
+	})
+	if helper.IgnorePreconditionError(err) != nil {
+		return diag.Errorf("failed to update secret generator: %v", err)
+	}
+	d.SetId(generatorType)
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetSecretGenerator(ctx, &admin.GetSecretGeneratorRequest{
+		GeneratorType: settings.SecretGeneratorType(settings.SecretGeneratorType_value[d.Id()]),
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to get secret generator")
+	}
+
+	for k, v := range secretGeneratorToMap(resp.GetSecretGenerator()) {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of secret generator: %v", k, err)
+		}
+	}
+	d.SetId(resp.GetSecretGenerator().GetGeneratorType().String())
+	return nil
+}
+
+func list(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started list")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetAdminClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ListSecretGenerators(ctx, &admin.ListSecretGeneratorsRequest{})
+	if err != nil {
+		return diag.Errorf("failed to list secret generators: %v", err)
+	}
+	generators := make([]map[string]interface{}, len(resp.GetResult()))
+	for i, generator := range resp.GetResult() {
+		generators[i] = secretGeneratorToMap(generator)
+	}
+	// If the ID is blank, the datasource is deleted and not usable.
+	d.SetId("-")
+	return diag.FromErr(d.Set(secretGeneratorsVar, generators))
+}
+
+func secretGeneratorToMap(generator *settings.SecretGenerator) map[string]interface{} {
+	return map[string]interface{}{
+		GeneratorTypeVar:       generator.GetGeneratorType().String(),
+		LengthVar:              int(generator.GetLength()),
+		ExpiryVar:              generator.GetExpiry().AsDuration().String(),
+		includeLowerLettersVar: generator.GetIncludeLowerLetters(),
+		includeUpperLettersVar: generator.GetIncludeUpperLetters(),
+		includeDigitsVar:       generator.GetIncludeDigits(),
+		includeSymbolsVar:      generator.GetIncludeSymbols(),
+	}
+}
+
+// customizeDiff makes sure that the generated secrets contain at least one character class
+func customizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	for _, key := range []string{includeLowerLettersVar, includeUpperLettersVar, includeDigitsVar, includeSymbolsVar} {
+		if diff.Get(key).(bool) {
+			return nil
+		}
+	}
+	return fmt.Errorf("at least one of %s, %s, %s or %s must be true", includeLowerLettersVar, includeUpperLettersVar, includeDigitsVar, includeSymbolsVar)
+}
+
+func validateGeneratorType(value interface{}) diag.Diagnostics {
+	if diags := helper.EnumValueValidation(GeneratorTypeVar, value, settings.SecretGeneratorType_value); diags.HasError() {
+		return diags
+	}
+	if value.(string) == settings.SecretGeneratorType_SECRET_GENERATOR_TYPE_UNSPECIFIED.String() {
+		return diag.Errorf("Attribute %s must not be %s", GeneratorTypeVar, value)
+	}
+	return nil
+}
+
+func validateLength(value interface{}) diag.Diagnostics {
+	length, ok := value.(int)
+	if !ok {
+		return diag.Errorf("Attribute %s is no integer", LengthVar)
+	}
+	if length < 1 {
+		return diag.Errorf("Attribute %s must be at least 1, but is %d", LengthVar, length)
+	}
+	return nil
+}
+
+func validateExpiry(value interface{}) diag.Diagnostics {
+	expiry, err := time.ParseDuration(value.(string))
+	if err != nil {
+		return diag.Errorf("Attribute %s is no valid duration: %v", ExpiryVar, err)
+	}
+	if expiry <= 0 {
+		return diag.Errorf("Attribute %s must be a positive duration, but is %s", ExpiryVar, expiry)
+	}
+	return nil
+}
+
+// suppressEqualDurations suppresses diffs between different notations of the same duration, e.g. 10m and 10m0s
+func suppressEqualDurations(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(oldValue)
+	if err != nil {
+		return false
+	}
+	newDuration, err := time.ParseDuration(newValue)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
+}
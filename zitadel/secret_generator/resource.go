@@ -0,0 +1,74 @@
+package secret_generator
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource representing the configuration of a secret generator of an instance, which is used to generate codes like the initialization code or the email verification code.",
+		Schema: map[string]*schema.Schema{
+			GeneratorTypeVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of the secret generator" + helper.DescriptionEnumValuesList(settings.SecretGeneratorType_name),
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return validateGeneratorType(value)
+				},
+			},
+			LengthVar: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Length of the generated secret",
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return validateLength(value)
+				},
+			},
+			ExpiryVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Expiry duration of the generated secret, e.g. 10m0s",
+				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+					return validateExpiry(value)
+				},
+				DiffSuppressFunc: suppressEqualDurations,
+			},
+			includeLowerLettersVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include lower case letters in the generated secret",
+			},
+			includeUpperLettersVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Include upper case letters in the generated secret",
+			},
+			includeDigitsVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Include digits in the generated secret",
+			},
+			includeSymbolsVar: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include symbols in the generated secret",
+			},
+		},
+		CreateContext: update,
+		UpdateContext: update,
+		DeleteContext: delete,
+		ReadContext:   read,
+		CustomizeDiff: customizeDiff,
+		Importer:      helper.ImportWithAttributes(helper.NewImportAttribute(GeneratorTypeVar, helper.ConvertNonEmpty, false)),
+	}
+}
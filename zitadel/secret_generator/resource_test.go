@@ -0,0 +1,53 @@
+package secret_generator_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/secret_generator"
+)
+
+func TestAccSecretGenerator(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_secret_generator")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	generatorType := test_utils.AttributeValue(t, secret_generator.GeneratorTypeVar, exampleAttributes).AsString()
+	exampleProperty := test_utils.AttributeValue(t, secret_generator.ExpiryVar, exampleAttributes).AsString()
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, "48h0m0s",
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame, generatorType),
+		regexp.MustCompile(fmt.Sprintf("^%s$", generatorType)),
+		test_utils.CheckNothing,
+		test_utils.ImportResourceId(frame.BaseTestFrame),
+	)
+}
+
+func checkRemoteProperty(frame test_utils.InstanceTestFrame, generatorType string) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.GetSecretGenerator(frame, &admin.GetSecretGeneratorRequest{
+				GeneratorType: settings.SecretGeneratorType(settings.SecretGeneratorType_value[generatorType]),
+			})
+			if err != nil {
+				return fmt.Errorf("getting secret generator failed: %w", err)
+			}
+			actual := resp.GetSecretGenerator().GetExpiry().AsDuration().String()
+			if actual != expect {
+				return fmt.Errorf("expected %s, but got %s", expect, actual)
+			}
+			return nil
+		}
+	}
+}
@@ -10,4 +10,6 @@ const (
 	PasswordVar       = "password"
 	replyToAddressVar = "reply_to_address"
 	SetActiveVar      = "set_active"
+	DescriptionVar    = "description"
+	TestRecipientVar  = "test_recipient"
 )
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 )
@@ -54,6 +55,12 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		Tls:            d.Get(tlsVar).(bool),
 		Password:       d.Get(PasswordVar).(string),
 		ReplyToAddress: d.Get(replyToAddressVar).(string),
+		Description:    d.Get(DescriptionVar).(string),
+	}
+
+	// the test email is sent before the configuration is added, so a failing test doesn't leave a tainted configuration
+	if diags := sendTestEmail(ctx, client, d, ""); diags.HasError() {
+		return diags
 	}
 
 	resp, err := client.AddSMTPConfig(ctx, req)
@@ -67,7 +74,10 @@ func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			return diag.Errorf("failed to activate smtp config: %v", err)
 		}
 	}
-
+	// set_active is computed if it is omitted, so the state records whether the new smtp config is active
+	if err := d.Set(SetActiveVar, d.Get(SetActiveVar).(bool)); err != nil {
+		return diag.Errorf("failed to set %s of smtp config: %v", SetActiveVar, err)
+	}
 	return nil
 }
 
@@ -84,7 +94,14 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges(SenderAddressVar, SenderNameVar, tlsVar, hostVar, userVar, replyToAddressVar, PasswordVar) {
+	// the planned settings are tested before they are applied, so a failing test doesn't leave broken settings active.
+	// The state keeps the previous settings, so the next plan shows the changes again.
+	if diags := sendTestEmail(ctx, client, d, d.Id()); diags.HasError() {
+		d.Partial(true)
+		return diags
+	}
+
+	if d.HasChanges(SenderAddressVar, SenderNameVar, tlsVar, hostVar, userVar, replyToAddressVar, PasswordVar, DescriptionVar) {
 		_, err = client.UpdateSMTPConfig(ctx, &admin.UpdateSMTPConfigRequest{
 			Id:             d.Id(),
 			SenderAddress:  d.Get(SenderAddressVar).(string),
@@ -94,21 +111,51 @@ func update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Dia
 			User:           d.Get(userVar).(string),
 			ReplyToAddress: d.Get(replyToAddressVar).(string),
 			Password:       d.Get(PasswordVar).(string),
+			Description:    d.Get(DescriptionVar).(string),
 		})
 		if err != nil {
 			return diag.Errorf("failed to update smtp config: %v", err)
 		}
 	}
 
-	if d.HasChange(SetActiveVar) && d.Get(SetActiveVar).(bool) {
-		if _, err := client.ActivateSMTPConfig(ctx, &admin.ActivateSMTPConfigRequest{Id: d.Id()}); err != nil {
-			return diag.Errorf("failed to activate smtp config: %v", err)
+	if d.HasChange(SetActiveVar) {
+		if d.Get(SetActiveVar).(bool) {
+			if _, err := client.ActivateSMTPConfig(ctx, &admin.ActivateSMTPConfigRequest{Id: d.Id()}); err != nil {
+				return diag.Errorf("failed to activate smtp config: %v", err)
+			}
+		} else {
+			if _, err := client.DeactivateSMTPConfig(ctx, &admin.DeactivateSMTPConfigRequest{Id: d.Id()}); err != nil {
+				return diag.Errorf("failed to deactivate smtp config: %v", err)
+			}
 		}
 	}
 
 	return nil
 }
 
+// sendTestEmail sends a test email with the configured settings if a test recipient is configured.
+// With the ID of an existing configuration, ZITADEL uses its stored password if no password is configured.
+func sendTestEmail(ctx context.Context, client *admin.Client, d *schema.ResourceData, id string) diag.Diagnostics {
+	recipient := d.Get(TestRecipientVar).(string)
+	if recipient == "" {
+		return nil
+	}
+	tflog.Info(ctx, "sending test email")
+	if _, err := client.TestSMTPConfig(ctx, &admin.TestSMTPConfigRequest{
+		Id:              id,
+		SenderAddress:   d.Get(SenderAddressVar).(string),
+		SenderName:      d.Get(SenderNameVar).(string),
+		Tls:             d.Get(tlsVar).(bool),
+		Host:            d.Get(hostVar).(string),
+		User:            d.Get(userVar).(string),
+		Password:        d.Get(PasswordVar).(string),
+		ReceiverAddress: recipient,
+	}); err != nil {
+		return diag.Errorf("failed to send test email with smtp config: %v", err)
+	}
+	return nil
+}
+
 func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, "started read")
 
@@ -141,7 +188,9 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 		userVar:           resp.GetSmtpConfig().GetUser(),
 		PasswordVar:       d.Get(PasswordVar).(string),
 		replyToAddressVar: resp.GetSmtpConfig().GetReplyToAddress(),
-		SetActiveVar:      d.Get(SetActiveVar).(bool),
+		DescriptionVar:    resp.GetSmtpConfig().GetDescription(),
+		SetActiveVar:      resp.GetSmtpConfig().GetState() == settings.SMTPConfigState_SMTP_CONFIG_ACTIVE,
+		TestRecipientVar:  d.Get(TestRecipientVar).(string),
 	}
 	for k, v := range set {
 		if err := d.Set(k, v); err != nil {
@@ -46,10 +46,21 @@ func GetResource() *schema.Resource {
 				Optional:    true,
 				Description: "Address to reply to.",
 			},
+			DescriptionVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the SMTP configuration.",
+			},
 			SetActiveVar: {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Set the SMTP configuration active after creating/updating.",
+				Computed:    true,
+				Description: "Set the SMTP configuration active after creating/updating. Only one email provider of the instance can be active, activating this configuration deactivates the currently active one. Setting it to false deactivates the configuration, omitting it leaves the activation as it is. The state always reflects whether the configuration is active.",
+			},
+			TestRecipientVar: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set, a test email is sent to this address with the planned SMTP settings before they are created or updated. The apply fails if the email can't be sent, settings which fail the test aren't applied.",
 			},
 		},
 		CreateContext: create,
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/admin"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/settings"
 
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
 	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
@@ -19,6 +20,9 @@ func TestAccSMTPConfig(t *testing.T) {
 	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
 	senderAddressProperty := test_utils.AttributeValue(t, smtp_config.SenderAddressVar, exampleAttributes).AsString()
 	resourceExample = strings.Replace(resourceExample, senderAddressProperty, fmt.Sprintf("zitadel@%s", frame.InstanceDomain), 1)
+	// the example host doesn't accept emails, so no test email is sent
+	testRecipientProperty := test_utils.AttributeValue(t, smtp_config.TestRecipientVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, testRecipientProperty, "", 1)
 
 	exampleProperty := test_utils.AttributeValue(t, smtp_config.SenderNameVar, exampleAttributes).AsString()
 	updatedProperty := "updatedProperty"
@@ -44,6 +48,115 @@ func TestAccSMTPConfig(t *testing.T) {
 	)
 }
 
+func TestAccSMTPConfigTestRecipient(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_smtp_config")
+	server := test_utils.NewSMTPServer(t)
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	senderAddressProperty := test_utils.AttributeValue(t, smtp_config.SenderAddressVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, senderAddressProperty, fmt.Sprintf("zitadel@%s", frame.InstanceDomain), 1)
+	hostProperty := test_utils.AttributeValue(t, "host", exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, hostProperty, server.Host, 1)
+	// the test server doesn't support TLS
+	resourceExample = strings.Replace(resourceExample, "tls              = true", "tls              = false", 1)
+	recipient := test_utils.AttributeValue(t, smtp_config.TestRecipientVar, exampleAttributes).AsString()
+
+	exampleProperty := test_utils.AttributeValue(t, smtp_config.SenderNameVar, exampleAttributes).AsString()
+	updatedProperty := "updatedProperty"
+
+	exampleSecret := test_utils.AttributeValue(t, smtp_config.PasswordVar, exampleAttributes).AsString()
+	updatedSecret := "updatedSecret"
+
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.ReplaceAll(resourceExample, exampleProperty, exampleSecret),
+		exampleProperty, updatedProperty,
+		smtp_config.PasswordVar, exampleSecret, updatedSecret,
+		true,
+		func(expect string) resource.TestCheckFunc {
+			return resource.ComposeAggregateTestCheckFunc(
+				checkRemoteProperty(frame)(expect),
+				server.CheckReceived(recipient),
+			)
+		},
+		helper.ZitadelGeneratedIdOnlyRegex,
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(frame), ""),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportResourceId(frame.BaseTestFrame),
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, smtp_config.PasswordVar),
+		),
+		smtp_config.TestRecipientVar,
+	)
+}
+
+func TestAccSMTPConfigSetActive(t *testing.T) {
+	frame := test_utils.NewInstanceTestFrame(t, "zitadel_smtp_config")
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	senderAddressProperty := test_utils.AttributeValue(t, smtp_config.SenderAddressVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, senderAddressProperty, fmt.Sprintf("zitadel@%s", frame.InstanceDomain), 1)
+	// the example host doesn't accept emails, so no test email is sent
+	testRecipientProperty := test_utils.AttributeValue(t, smtp_config.TestRecipientVar, exampleAttributes).AsString()
+	resourceExample = strings.Replace(resourceExample, testRecipientProperty, "", 1)
+	exampleSetActive := "set_active       = true"
+	omittedConfig := strings.Replace(resourceExample, exampleSetActive, "", 1)
+	inactiveConfig := strings.Replace(resourceExample, exampleSetActive, "set_active       = false", 1)
+	var id string
+	test_utils.RunStepsTest(
+		t,
+		frame.BaseTestFrame,
+		nil,
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(frame), ""),
+		resource.TestStep{ // Check the configuration is activated
+			Config: resourceExample,
+			Check: func(state *terraform.State) error {
+				id = frame.State(state).ID
+				return test_utils.CheckAMinute(checkRemoteActive(frame, true))(state)
+			},
+		},
+		resource.TestStep{ // Check deactivating the configuration outside of terraform has a diff
+			PreConfig:          deactivate(t, frame, &id),
+			Config:             resourceExample,
+			ExpectNonEmptyPlan: true,
+			PlanOnly:           true,
+		},
+		resource.TestStep{ // Check the configuration is activated again
+			Config: resourceExample,
+			Check:  test_utils.CheckAMinute(checkRemoteActive(frame, true)),
+		},
+		resource.TestStep{ // Check omitting set_active leaves a configuration deactivated outside of terraform inactive
+			PreConfig: deactivate(t, frame, &id),
+			Config:    omittedConfig,
+			Check:     test_utils.CheckAMinute(checkRemoteActive(frame, false)),
+		},
+		resource.TestStep{ // Check setting set_active to false keeps the configuration inactive
+			Config: inactiveConfig,
+			Check:  test_utils.CheckAMinute(checkRemoteActive(frame, false)),
+		},
+	)
+}
+
+func deactivate(t *testing.T, frame *test_utils.InstanceTestFrame, id *string) func() {
+	return func() {
+		if _, err := frame.DeactivateSMTPConfig(frame, &admin.DeactivateSMTPConfigRequest{Id: *id}); err != nil {
+			t.Fatalf("deactivating smtp config failed: %v", err)
+		}
+	}
+}
+
+func checkRemoteActive(frame *test_utils.InstanceTestFrame, expect bool) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resp, err := frame.GetSMTPConfigById(frame, &admin.GetSMTPConfigByIdRequest{Id: frame.State(state).ID})
+		if err != nil {
+			return fmt.Errorf("getting smtp config failed: %w", err)
+		}
+		if actual := resp.GetSmtpConfig().GetState() == settings.SMTPConfigState_SMTP_CONFIG_ACTIVE; actual != expect {
+			return fmt.Errorf("expected active to be %t, but got %t", expect, actual)
+		}
+		return nil
+	}
+}
+
 func checkRemoteProperty(frame *test_utils.InstanceTestFrame) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
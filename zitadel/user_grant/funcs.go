@@ -125,3 +125,8 @@ func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagn
 	d.SetId(grant.GetId())
 	return nil
 }
+
+// customizeDiff validates the role keys against the roles of the project or of the project grant at plan time
+func customizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return helper.ValidateRoleKeys(ctx, d, m, projectIDVar, projectGrantIDVar, RoleKeysVar)
+}
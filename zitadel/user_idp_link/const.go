@@ -0,0 +1,8 @@
+package user_idp_link
+
+const (
+	UserIDVar           = "user_id"
+	IdpIDVar            = "idp_id"
+	ProvidedUserIDVar   = "provided_user_id"
+	ProvidedUserNameVar = "provided_user_name"
+)
@@ -0,0 +1,119 @@
+package user_idp_link
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	userv2 "github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/user/v2"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started create")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetUserV2Client(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userID := d.Get(UserIDVar).(string)
+	idpID := d.Get(IdpIDVar).(string)
+	providedUserID := d.Get(ProvidedUserIDVar).(string)
+	_, err = client.AddIDPLink(ctx, &userv2.AddIDPLinkRequest{
+		UserId: userID,
+		IdpLink: &userv2.IDPLink{
+			IdpId:    idpID,
+			UserId:   providedUserID,
+			UserName: d.Get(ProvidedUserNameVar).(string),
+		},
+	})
+	if err != nil {
+		return diag.Errorf("failed to link identity provider: %v", err)
+	}
+	d.SetId(getUserIDPLinkID(userID, idpID, providedUserID))
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userID := d.Get(UserIDVar).(string)
+	idpID := d.Get(IdpIDVar).(string)
+	providedUserID := d.Get(ProvidedUserIDVar).(string)
+	resp, err := client.ListHumanLinkedIDPs(helper.CtxWithOrgID(ctx, d), &management.ListHumanLinkedIDPsRequest{UserId: userID})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to list linked identity providers: %v", err)
+	}
+	for _, link := range resp.GetResult() {
+		if link.GetIdpId() != idpID || link.GetProvidedUserId() != providedUserID {
+			continue
+		}
+		set := map[string]interface{}{
+			helper.OrgIDVar:     link.GetDetails().GetResourceOwner(),
+			UserIDVar:           link.GetUserId(),
+			IdpIDVar:            link.GetIdpId(),
+			ProvidedUserIDVar:   link.GetProvidedUserId(),
+			ProvidedUserNameVar: link.GetProvidedUserName(),
+		}
+		for k, v := range set {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("failed to set %s of identity provider link: %v", k, err)
+			}
+		}
+		d.SetId(getUserIDPLinkID(userID, idpID, providedUserID))
+		return nil
+	}
+	d.SetId("")
+	return nil
+}
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+
+	client, err := helper.GetUserV2Client(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.RemoveIDPLink(ctx, &userv2.RemoveIDPLinkRequest{
+		UserId:       d.Get(UserIDVar).(string),
+		IdpId:        d.Get(IdpIDVar).(string),
+		LinkedUserId: d.Get(ProvidedUserIDVar).(string),
+	})
+	if err != nil {
+		return diag.Errorf("failed to remove identity provider link: %v", err)
+	}
+	return nil
+}
+
+func getUserIDPLinkID(userID, idpID, providedUserID string) string {
+	return userID + "_" + idpID + "_" + providedUserID
+}
@@ -0,0 +1,49 @@
+package user_idp_link
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func GetResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Links an identity of an external identity provider to a human user, so a login with this identity authenticates the existing user instead of registering a new one. Both instance and organization identity providers can be linked.",
+		Schema: map[string]*schema.Schema{
+			helper.OrgIDVar: helper.OrgIDResourceField,
+			UserIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the human user",
+				ForceNew:    true,
+			},
+			IdpIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the identity provider",
+				ForceNew:    true,
+			},
+			ProvidedUserIDVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the user in the identity provider",
+				ForceNew:    true,
+			},
+			ProvidedUserNameVar: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Username of the user in the identity provider",
+				ForceNew:    true,
+			},
+		},
+		CreateContext: create,
+		DeleteContext: delete,
+		ReadContext:   read,
+		Importer: helper.ImportWithEmptyID(
+			helper.ImportOptionalOrgAttribute,
+			helper.NewImportAttribute(UserIDVar, helper.ConvertID, false),
+			helper.NewImportAttribute(IdpIDVar, helper.ConvertID, false),
+			helper.NewImportAttribute(ProvidedUserIDVar, helper.ConvertNonEmpty, false),
+		),
+	}
+}
@@ -0,0 +1,66 @@
+package user_idp_link_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/human_user/human_user_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/idp_google/idp_google_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/user_idp_link"
+)
+
+func TestAccUserIDPLink(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_user_idp_link")
+	userDep, userID := human_user_test_dep.Create(t, frame)
+	idpDep, _ := idp_google_test_dep.Create(t, frame.BaseTestFrame, frame.Admin)
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	providedUserID := test_utils.AttributeValue(t, user_idp_link.ProvidedUserIDVar, exampleAttributes).AsString()
+	exampleProperty := test_utils.AttributeValue(t, user_idp_link.ProvidedUserNameVar, exampleAttributes).AsString()
+	updatedProperty := "updated@example.com"
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, userDep, idpDep},
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame, userID, providedUserID),
+		regexp.MustCompile(fmt.Sprintf(`^%s_%s_%s$`, helper.ZitadelGeneratedIdPattern, helper.ZitadelGeneratedIdPattern, providedUserID)),
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(*frame, userID, providedUserID), ""),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, user_idp_link.UserIDVar),
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, user_idp_link.IdpIDVar),
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, user_idp_link.ProvidedUserIDVar),
+			test_utils.ImportOrgId(frame),
+		),
+	)
+}
+
+func checkRemoteProperty(frame test_utils.OrgTestFrame, userID, providedUserID string) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.ListHumanLinkedIDPs(frame, &management.ListHumanLinkedIDPsRequest{UserId: userID})
+			if err != nil {
+				return err
+			}
+			for _, link := range resp.GetResult() {
+				if link.GetProvidedUserId() != providedUserID {
+					continue
+				}
+				if actual := link.GetProvidedUserName(); actual != expect {
+					return fmt.Errorf("expected provided user name %s, but got %s", expect, actual)
+				}
+				return nil
+			}
+			return fmt.Errorf("link for provided user %s: %w", providedUserID, test_utils.ErrNotFound)
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package user_metadata
 
 const (
-	UserIDVar = "user_id"
-	KeyVar    = "key"
-	ValueVar  = "value"
+	UserIDVar      = "user_id"
+	KeyVar         = "key"
+	ValueVar       = "value"
+	ValueBase64Var = "value_base64"
+	ValueJSONVar   = "value_json"
 )
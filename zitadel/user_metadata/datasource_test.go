@@ -0,0 +1,40 @@
+package user_metadata_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/human_user/human_user_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/user_metadata"
+)
+
+func TestAccUserMetadataDatasource(t *testing.T) {
+	datasourceName := "zitadel_user_metadata"
+	frame := test_utils.NewOrgTestFrame(t, datasourceName)
+	config, attributes := test_utils.ReadExample(t, test_utils.Datasources, datasourceName)
+	key := test_utils.AttributeValue(t, user_metadata.KeyVar, attributes).AsString()
+	userDep, userID := human_user_test_dep.Create(t, frame)
+	value := `{ "department": "engineering" }`
+	if _, err := frame.SetUserMetadata(frame, &management.SetUserMetadataRequest{
+		Id:    userID,
+		Key:   key,
+		Value: []byte(value),
+	}); err != nil {
+		t.Fatalf("failed to set metadata: %v", err)
+	}
+	test_utils.RunDatasourceTest(
+		t,
+		frame.BaseTestFrame,
+		config,
+		[]string{frame.AsOrgDefaultDependency, userDep},
+		nil,
+		map[string]string{
+			"value":        value,
+			"value_base64": base64.StdEncoding.EncodeToString([]byte(value)),
+			"value_json":   `{"department":"engineering"}`,
+		},
+	)
+}
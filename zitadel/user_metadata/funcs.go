@@ -22,7 +22,10 @@ func set(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagno
 
 	userID := d.Get(UserIDVar).(string)
 	key := d.Get(KeyVar).(string)
-	value := []byte(d.Get(ValueVar).(string))
+	value, err := helper.GetMetadataValue(d)
+	if err != nil {
+		return diag.Errorf("failed to get metadata value: %v", err)
+	}
 	_, err = client.SetUserMetadata(helper.CtxWithOrgID(ctx, d), &management.SetUserMetadataRequest{
 		Id:    userID,
 		Key:   key,
@@ -35,37 +38,38 @@ func set(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagno
 	return nil
 }
 
-func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	clientinfo, ok := m.(*helper.ClientInfo)
-	if !ok {
-		return diag.Errorf("failed to get client")
-	}
-	client, err := helper.GetManagementClient(ctx, clientinfo)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	userID := d.Get(UserIDVar).(string)
-	key := d.Get(KeyVar).(string)
-	resp, err := client.GetUserMetadata(helper.CtxWithOrgID(ctx, d), &management.GetUserMetadataRequest{Id: userID, Key: key})
-	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
-		d.SetId("")
-		return nil
-	}
-	if err != nil {
-		return diag.Errorf("failed to get metadata object")
-	}
-	set := map[string]interface{}{
-		UserIDVar: userID,
-		KeyVar:    key,
-		ValueVar:  string(resp.GetMetadata().GetValue()),
-	}
-	for k, v := range set {
-		if err := d.Set(k, v); err != nil {
-			return diag.Errorf("failed to set metadata with key %s: %v", k, err)
+// readFunc sets the value in the configured representation for resources and in all representations for data sources
+func readFunc(forDatasource bool) func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		clientinfo, ok := m.(*helper.ClientInfo)
+		if !ok {
+			return diag.Errorf("failed to get client")
+		}
+		client, err := helper.GetManagementClient(ctx, clientinfo)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		userID := d.Get(UserIDVar).(string)
+		key := d.Get(KeyVar).(string)
+		resp, err := client.GetUserMetadata(helper.CtxWithOrgID(ctx, d), &management.GetUserMetadataRequest{Id: userID, Key: key})
+		if err != nil && helper.IgnoreIfNotFoundError(err) == nil && !forDatasource {
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return diag.Errorf("failed to get metadata object")
+		}
+		set := helper.MetadataValueState(d, resp.GetMetadata().GetValue(), forDatasource)
+		set[UserIDVar] = userID
+		set[KeyVar] = key
+		for k, v := range set {
+			if err := d.Set(k, v); err != nil {
+				return diag.Errorf("failed to set metadata with key %s: %v", k, err)
+			}
+		}
+		d.SetId(getUserMetadataID(userID, key))
+		return nil
 	}
-	d.SetId(getUserMetadataID(userID, key))
-	return nil
 }
 
 func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
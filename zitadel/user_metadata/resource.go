@@ -23,15 +23,13 @@ func GetResource() *schema.Resource {
 				Description: "The key of a metadata entry",
 				ForceNew:    true,
 			},
-			ValueVar: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The string representation of a metadata entry value. For binary data, use the base64encode function.",
-			},
+			ValueVar:       helper.MetadataValueResourceField,
+			ValueBase64Var: helper.MetadataValueBase64ResourceField,
+			ValueJSONVar:   helper.MetadataValueJSONResourceField,
 		},
 		CreateContext: set,
 		DeleteContext: delete,
-		ReadContext:   read,
+		ReadContext:   readFunc(false),
 		UpdateContext: set,
 		Importer:      helper.ImportWithEmptyID(helper.ImportOptionalOrgAttribute, helper.NewImportAttribute(UserIDVar, helper.ConvertID, false), helper.NewImportAttribute(KeyVar, helper.ConvertNonEmpty, false)),
 	}
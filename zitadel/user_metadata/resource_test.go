@@ -1,8 +1,10 @@
 package user_metadata_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -19,6 +21,8 @@ func TestAccUserMetadata(t *testing.T) {
 	frame := test_utils.NewOrgTestFrame(t, "zitadel_user_metadata")
 	userDep, userID := human_user_test_dep.Create(t, frame)
 	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	// the example also shows binary and JSON values, so we cut it down to the first block
+	resourceExample = strings.Join(strings.Split(resourceExample, "\n")[0:6], "\n")
 	keyProperty := test_utils.AttributeValue(t, user_metadata.KeyVar, exampleAttributes).AsString()
 	exampleProperty := test_utils.AttributeValue(t, user_metadata.ValueVar, exampleAttributes).AsString()
 	updatedProperty := "another_value"
@@ -41,6 +45,64 @@ func TestAccUserMetadata(t *testing.T) {
 	)
 }
 
+func TestAccUserMetadata_JSON(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_user_metadata")
+	userDep, userID := human_user_test_dep.Create(t, frame)
+	keyProperty := "a_json_key"
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, userDep},
+		func(department, _ string) string {
+			// the keys are ordered differently than in the normalized remote value, which must not show up as drift
+			return fmt.Sprintf(`
+resource "zitadel_user_metadata" "default" {
+  org_id     = data.zitadel_org.default.id
+  user_id    = data.zitadel_human_user.default.id
+  key        = "%s"
+  value_json = "{\"department\": \"%s\", \"cost_center\": 42}"
+}`, keyProperty, department)
+		},
+		"engineering", "sales",
+		"", "", "",
+		false,
+		checkRemoteJSONProperty(*frame, userID, keyProperty),
+		regexp.MustCompile(fmt.Sprintf(`^%s_%s$`, helper.ZitadelGeneratedIdPattern, keyProperty)),
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteJSONProperty(*frame, userID, keyProperty), ""),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, user_metadata.UserIDVar),
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, user_metadata.KeyVar),
+			test_utils.ImportOrgId(frame),
+		),
+		// an imported entry is read as plain string value
+		user_metadata.ValueVar, user_metadata.ValueJSONVar,
+	)
+}
+
+func checkRemoteJSONProperty(frame test_utils.OrgTestFrame, userID, key string) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.GetUserMetadata(frame, &management.GetUserMetadataRequest{
+				Id:  userID,
+				Key: key,
+			})
+			if err != nil {
+				return err
+			}
+			value := struct {
+				Department string `json:"department"`
+			}{}
+			if err := json.Unmarshal(resp.GetMetadata().GetValue(), &value); err != nil {
+				return fmt.Errorf("expected a JSON value: %v", err)
+			}
+			if expect != value.Department {
+				return fmt.Errorf("expected department %s, but got %s", expect, value.Department)
+			}
+			return nil
+		}
+	}
+}
+
 func checkRemoteProperty(frame test_utils.OrgTestFrame, userID, key string) func(string) resource.TestCheckFunc {
 	return func(expect string) resource.TestCheckFunc {
 		return func(state *terraform.State) error {
@@ -0,0 +1,7 @@
+package user_metadata_set
+
+const (
+	UserIDVar    = "user_id"
+	MetadataVar  = "metadata"
+	ExclusiveVar = "exclusive"
+)
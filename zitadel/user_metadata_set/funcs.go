@@ -0,0 +1,147 @@
+package user_metadata_set
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/metadata"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/object"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+)
+
+func set(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started set")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userID := d.Get(UserIDVar).(string)
+	orgCtx := helper.CtxWithOrgID(ctx, d)
+	remote, err := helper.ListAllMetadata(func(query *object.ListQuery) ([]*metadata.Metadata, error) {
+		resp, err := client.ListUserMetadata(orgCtx, &management.ListUserMetadataRequest{Id: userID, Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return diag.Errorf("failed to list metadata entries: %v", err)
+	}
+	previous, desired := d.GetChange(MetadataVar)
+	toSet, toRemove := helper.MetadataChanges(helper.MetadataMap(desired), remote, helper.MetadataMap(previous), d.Get(ExclusiveVar).(bool))
+	if len(toRemove) > 0 {
+		_, err = client.BulkRemoveUserMetadata(orgCtx, &management.BulkRemoveUserMetadataRequest{Id: userID, Keys: toRemove})
+		if err != nil {
+			return diag.Errorf("failed to remove metadata entries: %v", err)
+		}
+	}
+	if len(toSet) > 0 {
+		entries := make([]*management.BulkSetUserMetadataRequest_Metadata, 0, len(toSet))
+		for _, key := range helper.SortedMetadataKeys(toSet) {
+			entries = append(entries, &management.BulkSetUserMetadataRequest_Metadata{Key: key, Value: []byte(toSet[key])})
+		}
+		_, err = client.BulkSetUserMetadata(orgCtx, &management.BulkSetUserMetadataRequest{Id: userID, Metadata: entries})
+		if err != nil {
+			return diag.Errorf("failed to set metadata entries: %v", err)
+		}
+	}
+	d.SetId(userID)
+	return nil
+}
+
+func read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started read")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userID := helper.GetID(d, UserIDVar)
+	remote, err := helper.ListAllMetadata(func(query *object.ListQuery) ([]*metadata.Metadata, error) {
+		resp, err := client.ListUserMetadata(helper.CtxWithOrgID(ctx, d), &management.ListUserMetadataRequest{Id: userID, Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil && helper.IgnoreIfNotFoundError(err) == nil {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to list metadata entries: %v", err)
+	}
+	set := map[string]interface{}{
+		UserIDVar:   userID,
+		MetadataVar: helper.TrackedMetadata(remote, helper.MetadataMap(d.Get(MetadataVar)), d.Get(ExclusiveVar).(bool)),
+	}
+	for k, v := range set {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s of metadata set: %v", k, err)
+		}
+	}
+	d.SetId(userID)
+	return nil
+}
+
+func delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "started delete")
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return diag.Errorf("failed to get client")
+	}
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := helper.SortedMetadataKeys(helper.MetadataMap(d.Get(MetadataVar)))
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err = client.BulkRemoveUserMetadata(helper.CtxWithOrgID(ctx, d), &management.BulkRemoveUserMetadataRequest{Id: d.Get(UserIDVar).(string), Keys: keys})
+	if err := helper.IgnoreIfNotFoundError(err); err != nil {
+		return diag.Errorf("failed to remove metadata entries: %v", err)
+	}
+	return nil
+}
+
+// importState tracks all remote metadata entries in the state, as the imported resource manages the entries which exist at the time of the import
+func importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	states, err := helper.ImportWithIDAndOptionalOrg(UserIDVar).StateContext(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	clientinfo, ok := m.(*helper.ClientInfo)
+	if !ok {
+		return nil, fmt.Errorf("failed to get client")
+	}
+	client, err := helper.GetManagementClient(ctx, clientinfo)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := helper.ListAllMetadata(func(query *object.ListQuery) ([]*metadata.Metadata, error) {
+		resp, err := client.ListUserMetadata(helper.CtxWithOrgID(ctx, d), &management.ListUserMetadataRequest{Id: d.Id(), Query: query})
+		return resp.GetResult(), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list metadata entries: %w", err)
+	}
+	if err := d.Set(MetadataVar, helper.TrackedMetadata(remote, nil, true)); err != nil {
+		return nil, fmt.Errorf("failed to set %s of metadata set: %w", MetadataVar, err)
+	}
+	return states, nil
+}
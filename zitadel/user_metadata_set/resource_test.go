@@ -0,0 +1,99 @@
+package user_metadata_set_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zitadel/zitadel-go/v3/pkg/client/zitadel/management"
+
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/helper/test_utils"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/human_user/human_user_test_dep"
+	"github.com/zitadel/terraform-provider-zitadel/v2/zitadel/user_metadata_set"
+)
+
+const (
+	exampleKey   = "a_key"
+	unmanagedKey = "unmanaged_key"
+)
+
+func TestAccUserMetadataSet(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_user_metadata_set")
+	userDep, userID := human_user_test_dep.Create(t, frame)
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleProperty := test_utils.AttributeValue(t, user_metadata_set.MetadataVar, exampleAttributes).AsValueMap()[exampleKey].AsString()
+	updatedProperty := "updated_value"
+	test_utils.RunLifecyleTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, userDep},
+		test_utils.ReplaceAll(resourceExample, exampleProperty, ""),
+		exampleProperty, updatedProperty,
+		"", "", "",
+		false,
+		checkRemoteProperty(*frame, userID),
+		regexp.MustCompile(fmt.Sprintf(`^%s$`, helper.ZitadelGeneratedIdPattern)),
+		test_utils.CheckIsNotFoundFromPropertyCheck(checkRemoteProperty(*frame, userID), ""),
+		test_utils.ChainImportStateIdFuncs(
+			test_utils.ImportStateAttribute(frame.BaseTestFrame, user_metadata_set.UserIDVar),
+			test_utils.ImportOrgId(frame),
+		),
+	)
+}
+
+func TestAccUserMetadataSetUnmanagedEntries(t *testing.T) {
+	frame := test_utils.NewOrgTestFrame(t, "zitadel_user_metadata_set")
+	userDep, userID := human_user_test_dep.Create(t, frame)
+	resourceExample, exampleAttributes := test_utils.ReadExample(t, test_utils.Resources, frame.ResourceType)
+	exampleEntries := len(test_utils.AttributeValue(t, user_metadata_set.MetadataVar, exampleAttributes).AsValueMap())
+	test_utils.RunStepsTest(
+		t,
+		frame.BaseTestFrame,
+		[]string{frame.AsOrgDefaultDependency, userDep},
+		nil,
+		resource.TestStep{ // Check the example entries are created
+			Config: resourceExample,
+		},
+		resource.TestStep{ // Check entries added outside of terraform are neither tracked nor removed
+			PreConfig: func() {
+				if _, err := frame.SetUserMetadata(frame, &management.SetUserMetadataRequest{Id: userID, Key: unmanagedKey, Value: []byte("unmanaged")}); err != nil {
+					t.Fatalf("setting unmanaged metadata failed: %v", err)
+				}
+			},
+			Config: resourceExample,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(frame.TerraformName, user_metadata_set.MetadataVar+".%", strconv.Itoa(exampleEntries)),
+				test_utils.CheckAMinute(checkRemoteEntry(*frame, userID, unmanagedKey)("unmanaged")),
+			),
+		},
+	)
+}
+
+func checkRemoteProperty(frame test_utils.OrgTestFrame, userID string) func(string) resource.TestCheckFunc {
+	return checkRemoteEntry(frame, userID, exampleKey)
+}
+
+func checkRemoteEntry(frame test_utils.OrgTestFrame, userID, key string) func(string) resource.TestCheckFunc {
+	return func(expect string) resource.TestCheckFunc {
+		return func(state *terraform.State) error {
+			resp, err := frame.ListUserMetadata(frame, &management.ListUserMetadataRequest{Id: userID})
+			if err != nil {
+				return err
+			}
+			for _, entry := range resp.GetResult() {
+				if entry.GetKey() != key {
+					continue
+				}
+				if actual := string(entry.GetValue()); actual != expect {
+					return fmt.Errorf("expected value %s, but got %s", expect, actual)
+				}
+				return nil
+			}
+			return fmt.Errorf("metadata entry %s: %w", key, test_utils.ErrNotFound)
+		}
+	}
+}
@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &verifyEmailMessageTextResource{}
+	_ resource.Resource               = &verifyEmailMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &verifyEmailMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -85,6 +86,10 @@ func (r *verifyEmailMessageTextResource) Configure(_ context.Context, req resour
 	r.clientInfo = clientInfo
 }
 
+func (r *verifyEmailMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *verifyEmailMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -381,4 +386,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}
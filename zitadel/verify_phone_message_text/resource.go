@@ -26,7 +26,8 @@ const (
 )
 
 var (
-	_ resource.Resource = &verifyPhoneMessageTextResource{}
+	_ resource.Resource               = &verifyPhoneMessageTextResource{}
+	_ resource.ResourceWithModifyPlan = &verifyPhoneMessageTextResource{}
 )
 
 func New() resource.Resource {
@@ -87,6 +88,10 @@ func (r *verifyPhoneMessageTextResource) Configure(_ context.Context, req resour
 	r.clientInfo = clientInfo
 }
 
+func (r *verifyPhoneMessageTextResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(helper.ValidatePlannedLanguage(ctx, r.clientInfo, req.State, req.Plan, LanguageVar)...)
+}
+
 func (r *verifyPhoneMessageTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	language := getPlanAttrs(ctx, req.Plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -383,4 +388,4 @@ func isResourceNotFoundError(err error) bool {
 	return strings.Contains(errStr, "not found") ||
 		strings.Contains(errStr, "not_found") ||
 		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
+}